@@ -0,0 +1,228 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/asa"
+)
+
+func TestVersion_Next(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "9.18.3.56",
+			args:   args{ver: "9.18.3.56"},
+			want:   "9.18.4.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.18.3",
+			args:   args{ver: "9.18.3"},
+			want:   "9.18.3.56",
+			wantOK: true,
+		},
+		{
+			name:   "9.22.1",
+			args:   args{ver: "9.22.1"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Next()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Next() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_Prev(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "9.18.3.56",
+			args:   args{ver: "9.18.3.56"},
+			want:   "9.18.3.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.18.3",
+			args:   args{ver: "9.18.3"},
+			want:   "9.18.2.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.22.1",
+			args:   args{ver: "9.22.1"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Prev()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Prev() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMaintenance(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "9.18.3.56",
+			args:   args{ver: "9.18.3.56"},
+			want:   "9.18.4.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.18.3",
+			args:   args{ver: "9.18.3"},
+			want:   "9.18.4.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.22.1",
+			args:   args{ver: "9.22.1"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMaintenance()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMaintenance() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMinor(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "9.18.3.56",
+			args:   args{ver: "9.18.3.56"},
+			want:   "9.19.1.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.18.3",
+			args:   args{ver: "9.18.3"},
+			want:   "9.19.1.0",
+			wantOK: true,
+		},
+		{
+			name:   "9.22.1",
+			args:   args{ver: "9.22.1"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMinor()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMinor() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestKnown(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "9.18.3",
+			args: args{ver: "9.18.3"},
+			want: true,
+		},
+		{
+			name: "9.18(3)56",
+			args: args{ver: "9.18(3)56"},
+			want: true,
+		},
+		{
+			name: "9.18.3.55",
+			args: args{ver: "9.18.3.55"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := version.Known(v); got != tt.want {
+				t.Errorf("Known() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleases(t *testing.T) {
+	rs := version.Releases()
+	if len(rs) == 0 {
+		t.Fatalf("Releases() is empty")
+	}
+	for i := 1; i < len(rs); i++ {
+		if rs[i-1].SortKey() >= rs[i].SortKey() {
+			t.Errorf("Releases() is not in ascending order: %v >= %v", rs[i-1], rs[i])
+		}
+	}
+	if version.CatalogVersion() == "" {
+		t.Errorf("CatalogVersion() is empty")
+	}
+}
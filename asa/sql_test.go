@@ -0,0 +1,91 @@
+package version_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/asa"
+)
+
+func TestVersion_Scan(t *testing.T) {
+	type args struct {
+		src any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: args{src: "9.18.3.56"},
+			want: version.Version{Major: 9, Minor: 18, Maintenance: 3, Vulnerability: 56},
+		},
+		{
+			name: "[]byte",
+			args: args{src: []byte("9.18.3.56")},
+			want: version.Version{Major: 9, Minor: 18, Maintenance: 3, Vulnerability: 56},
+		},
+		{
+			name: "nil",
+			args: args{src: nil},
+		},
+		{
+			name:    "int64",
+			args:    args{src: int64(1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got version.Version
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Version.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Value(t *testing.T) {
+	v := version.Version{Major: 9, Minor: 18, Maintenance: 3, Vulnerability: 56}
+	got, err := v.Value()
+	if err != nil {
+		t.Fatalf("Version.Value() error = %v", err)
+	}
+	if got != v.Canonical() {
+		t.Errorf("Version.Value() = %v, want %v", got, v.Canonical())
+	}
+}
+
+func TestVersion_SortKey(t *testing.T) {
+	var vs []version.Version
+	for _, s := range []string{
+		"9.8.4",
+		"9.8.4.10",
+		"9.12.1",
+		"9.18.3.56",
+		"9.18.4",
+		"10.0.1",
+	} {
+		v, err := version.NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+
+	for _, v1 := range vs {
+		for _, v2 := range vs {
+			want := v1.Compare(v2)
+			if got := cmp.Compare(v1.SortKey(), v2.SortKey()); got != want {
+				t.Errorf("cmp.Compare(%q.SortKey(), %q.SortKey()) = %d, want %d", v1, v2, got, want)
+			}
+		}
+	}
+}
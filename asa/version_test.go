@@ -1,15 +1,18 @@
 package version_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	version "github.com/MaineK00n/go-cisco-version/asa"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 func TestNewVersion(t *testing.T) {
 	type args struct {
-		ver string
+		ver  string
+		opts []parseopt.Options
 	}
 	tests := []struct {
 		name    string
@@ -52,10 +55,20 @@ func TestNewVersion(t *testing.T) {
 			args:    args{ver: "7.0(1)1a"},
 			wantErr: true,
 		},
+		{
+			name: "lenient v9.18(3)56",
+			args: args{ver: " v9.18(3)56", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			want: version.Version{Major: 9, Minor: 18, Maintenance: 3, Vulnerability: 56},
+		},
+		{
+			name:    "strict v9.18(3)56",
+			args:    args{ver: "v9.18(3)56"},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := version.NewVersion(tt.args.ver)
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -67,6 +80,46 @@ func TestNewVersion(t *testing.T) {
 	}
 }
 
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "9.x.3",
+			args:       args{ver: "9.x.3"},
+			wantField:  "minor",
+			wantOffset: 2,
+			wantLength: 1,
+		},
+		{
+			name:       "9.18",
+			args:       args{ver: "9.18"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
 func TestVersion_Compare(t *testing.T) {
 	type fields struct {
 		Major         int
@@ -160,3 +213,80 @@ func TestVersion_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "9.18(3)56",
+			args:          args{ver: "9.18(3)56"},
+			wantOriginal:  "9.18(3)56",
+			wantCanonical: "9.18.3.56",
+		},
+		{
+			name:          "9.18.3",
+			args:          args{ver: "9.18.3"},
+			wantOriginal:  "9.18.3",
+			wantCanonical: "9.18.3.0",
+		},
+		{
+			name:          "lenient v9.18(3)56",
+			args:          args{ver: "v9.18(3)56", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			wantOriginal:  "v9.18(3)56",
+			wantCanonical: "9.18.3.56",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Train(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "9.18(3)56",
+			args: args{ver: "9.18(3)56"},
+			want: "9.18",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
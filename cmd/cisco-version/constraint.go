@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// constraint is a disjunction of conjunctions of comparisons, e.g. ">=17.9.1, <17.9.5 || >=17.12.1"
+type constraint [][]comparison
+
+type comparison struct {
+	op      string
+	version version
+}
+
+// operators is ordered so that a prefix match finds the longest operator first
+var operators = []string{"==", "!=", "<=", ">=", "=", "<", ">"}
+
+func parseConstraint(p platform, s string, opts parseopt.Options) (constraint, error) {
+	var c constraint
+	for _, or := range strings.Split(s, "||") {
+		var and []comparison
+		for _, term := range strings.Split(or, ",") {
+			term = strings.TrimSpace(term)
+			if term == "" {
+				return nil, fmt.Errorf("unexpected constraint format. expected: %q, actual: %q", "<op><version>(, <op><version>)( || ...)", s)
+			}
+
+			op := "="
+			for _, o := range operators {
+				if strings.HasPrefix(term, o) {
+					op, term = o, strings.TrimSpace(strings.TrimPrefix(term, o))
+					break
+				}
+			}
+			if op == "==" {
+				op = "="
+			}
+
+			v, err := p.parse(term, opts)
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, comparison{op: op, version: v})
+		}
+		c = append(c, and)
+	}
+	return c, nil
+}
+
+// check reports whether v satisfies the constraint.
+// A comparison with a version that v cannot be compared with, such as a different IOS release, is only satisfied by "!=".
+func (c constraint) check(v version) bool {
+	for _, and := range c {
+		ok := true
+		for _, cmp := range and {
+			if !cmp.check(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparison) check(v version) bool {
+	r, err := v.compare(c.version)
+	if err != nil {
+		return c.op == "!="
+	}
+	switch c.op {
+	case "=":
+		return r == 0
+	case "!=":
+		return r != 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	default:
+		return false
+	}
+}
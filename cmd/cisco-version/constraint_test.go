@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	type args struct {
+		platform   string
+		version    string
+		constraint string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "17.9.4a in >=17.9.1, <17.9.5 || >=17.12.1",
+			args: args{
+				platform:   "ios-xe",
+				version:    "17.9.4a",
+				constraint: ">=17.9.1, <17.9.5 || >=17.12.1",
+			},
+			want: true,
+		},
+		{
+			name: "17.9.5 in >=17.9.1, <17.9.5 || >=17.12.1",
+			args: args{
+				platform:   "ios-xe",
+				version:    "17.9.5",
+				constraint: ">=17.9.1, <17.9.5 || >=17.12.1",
+			},
+			want: false,
+		},
+		{
+			name: "17.12.2 in >=17.9.1, <17.9.5 || >=17.12.1",
+			args: args{
+				platform:   "ios-xe",
+				version:    "17.12.2",
+				constraint: ">=17.9.1, <17.9.5 || >=17.12.1",
+			},
+			want: true,
+		},
+		{
+			name: "9.18.3.56 in 9.18.3.56",
+			args: args{
+				platform:   "asa",
+				version:    "9.18.3.56",
+				constraint: "9.18.3.56",
+			},
+			want: true,
+		},
+		{
+			name: "9.18.3.56 in != 9.18.3.56",
+			args: args{
+				platform:   "asa",
+				version:    "9.18.3.56",
+				constraint: "!= 9.18.3.56",
+			},
+			want: false,
+		},
+		{
+			name: "15.2(4)E1 in >=15.2(4)M1",
+			args: args{
+				platform:   "ios",
+				version:    "15.2(4)E1",
+				constraint: ">=15.2(4)M1",
+			},
+			want: false,
+		},
+		{
+			name: "15.2(4)E1 in !=15.2(4)M1",
+			args: args{
+				platform:   "ios",
+				version:    "15.2(4)E1",
+				constraint: "!=15.2(4)M1",
+			},
+			want: true,
+		},
+		{
+			name: "empty term",
+			args: args{
+				platform:   "ios-xe",
+				version:    "17.9.4a",
+				constraint: ">=17.9.1, ",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid version",
+			args: args{
+				platform:   "ios-xe",
+				version:    "17.9.4a",
+				constraint: ">=17.x.1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, _ := lookupPlatform(tt.args.platform)
+			v, err := p.parse(tt.args.version, parseopt.Options{})
+			if err != nil {
+				t.Fatalf("parse() error = %v", err)
+			}
+			c, err := parseConstraint(p, tt.args.constraint, parseopt.Options{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got := c.check(v); got != tt.want {
+				t.Errorf("constraint.check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Command cisco-version parses, compares, sorts and checks Cisco software versions from the shell.
+//
+//	cisco-version parse -platform ios-xe 17.9.4a
+//	cisco-version compare -platform nx-os 9.3(8) 9.3(10)
+//	cisco-version sort -platform asa < versions.txt
+//	cisco-version check -platform ios-xe 17.9.4a '>=17.9.1, <17.9.5 || >=17.12.1'
+//	cisco-version detect '7.0(3)I7(9)'
+//
+// compare exits with 0 if the versions are equal, 1 if the first is smaller and 2 if it is greater.
+// check exits with 0 if the version satisfies the constraint and 1 otherwise.
+// Any error exits with 3 and is written to stderr as a single line JSON object {"error": {"code": ..., "message": ...}}.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/collection"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+const (
+	exitOK    = 0
+	exitFalse = 1
+	exitError = 3
+)
+
+const (
+	codeUsage        = "usage"
+	codeParse        = "parse"
+	codeIncomparable = "incomparable"
+	codeUndetected   = "undetected"
+	codeIO           = "io"
+)
+
+// cliError represents an error written to stderr
+type cliError struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Platform string `json:"platform,omitempty"`
+	Input    string `json:"input,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Offset   *int   `json:"offset,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Line     int    `json:"line,omitempty"`
+}
+
+func (e *cliError) Error() string {
+	return e.Message
+}
+
+func newError(code string, format string, a ...any) *cliError {
+	return &cliError{Code: code, Message: fmt.Sprintf(format, a...)}
+}
+
+// newParseError describes err returned by parsing input as a version of p
+func newParseError(p platform, input string, err error) *cliError {
+	e := &cliError{Code: codeParse, Message: err.Error(), Platform: p.name, Input: input}
+	var perr *parseerr.ParseError
+	if errors.As(err, &perr) {
+		e.Field, e.Offset, e.Expected = perr.Field, &perr.Offset, perr.Expected
+	}
+	return e
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	code, err := dispatch(args, stdin, stdout, stderr)
+	if err != nil {
+		var e *cliError
+		if !errors.As(err, &e) {
+			e = newError(codeUsage, "%v", err)
+		}
+		enc := json.NewEncoder(stderr)
+		enc.SetEscapeHTML(false)
+		_ = enc.Encode(struct {
+			Error *cliError `json:"error"`
+		}{Error: e})
+		return exitError
+	}
+	return code
+}
+
+func dispatch(args []string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	if len(args) == 0 {
+		return 0, newError(codeUsage, "missing command. expected: %q", []string{"parse", "compare", "sort", "check", "detect"})
+	}
+
+	switch args[0] {
+	case "parse":
+		return runParse(args[1:], stdout, stderr)
+	case "compare":
+		return runCompare(args[1:], stderr)
+	case "sort":
+		return runSort(args[1:], stdin, stdout, stderr)
+	case "check":
+		return runCheck(args[1:], stderr)
+	case "detect":
+		return runDetect(args[1:], stdout, stderr)
+	default:
+		return 0, newError(codeUsage, "unexpected command. expected: %q, actual: %q", []string{"parse", "compare", "sort", "check", "detect"}, args[0])
+	}
+}
+
+// flags is the flag set shared by the subcommands
+type flags struct {
+	*flag.FlagSet
+	stderr   io.Writer
+	platform string
+	lenient  bool
+}
+
+func newFlags(name string, stderr io.Writer, withPlatform bool) *flags {
+	f := &flags{FlagSet: flag.NewFlagSet(name, flag.ContinueOnError), stderr: stderr}
+	// flag errors are reported as cliError instead of the usage text
+	f.SetOutput(io.Discard)
+	if withPlatform {
+		f.StringVar(&f.platform, "platform", "", fmt.Sprintf("platform: %s", strings.Join(platformNames(), ", ")))
+	}
+	f.BoolVar(&f.lenient, "lenient", false, "normalize whitespace, prefixes, separators and zero padding before parsing")
+	return f
+}
+
+func (f *flags) parse(args []string, nargs int) (platform, error) {
+	if err := f.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			f.SetOutput(f.stderr)
+			f.PrintDefaults()
+		}
+		return platform{}, newError(codeUsage, "%v", err)
+	}
+	if nargs >= 0 && f.NArg() != nargs {
+		return platform{}, newError(codeUsage, "unexpected number of arguments to %s. expected: %d, actual: %d", f.Name(), nargs, f.NArg())
+	}
+	if f.Lookup("platform") == nil {
+		return platform{}, nil
+	}
+	p, ok := lookupPlatform(f.platform)
+	if !ok {
+		return platform{}, newError(codeUsage, "unexpected platform. expected: %q, actual: %q", platformNames(), f.platform)
+	}
+	return p, nil
+}
+
+func (f *flags) options() parseopt.Options {
+	if f.lenient {
+		return parseopt.Options{Mode: parseopt.Lenient}
+	}
+	return parseopt.Options{Mode: parseopt.Strict}
+}
+
+func (f *flags) version(p platform, s string) (version, error) {
+	v, err := p.parse(s, f.options())
+	if err != nil {
+		return version{}, newParseError(p, s, err)
+	}
+	return v, nil
+}
+
+// parsed is the output of the parse command
+type parsed struct {
+	Platform  string `json:"platform"`
+	Input     string `json:"input"`
+	Version   string `json:"version"`
+	Canonical string `json:"canonical"`
+	Train     string `json:"train"`
+	Known     bool   `json:"known"`
+	Fields    any    `json:"fields"`
+}
+
+func runParse(args []string, stdout, stderr io.Writer) (int, error) {
+	f := newFlags("parse", stderr, true)
+	p, err := f.parse(args, -1)
+	if err != nil {
+		return 0, err
+	}
+	if f.NArg() == 0 {
+		return 0, newError(codeUsage, "missing version to parse")
+	}
+
+	enc := json.NewEncoder(stdout)
+	enc.SetEscapeHTML(false)
+	for _, s := range f.Args() {
+		v, err := f.version(p, s)
+		if err != nil {
+			return 0, err
+		}
+		if err := enc.Encode(parsed{
+			Platform:  p.name,
+			Input:     s,
+			Version:   v.String(),
+			Canonical: v.Canonical(),
+			Train:     v.Train(),
+			Known:     v.known,
+			Fields:    v.object,
+		}); err != nil {
+			return 0, newError(codeIO, "write output. err: %v", err)
+		}
+	}
+	return exitOK, nil
+}
+
+func runCompare(args []string, stderr io.Writer) (int, error) {
+	f := newFlags("compare", stderr, true)
+	p, err := f.parse(args, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	v1, err := f.version(p, f.Arg(0))
+	if err != nil {
+		return 0, err
+	}
+	v2, err := f.version(p, f.Arg(1))
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := v1.compare(v2)
+	if err != nil {
+		e := newError(codeIncomparable, "compare %s and %s. err: %v", f.Arg(0), f.Arg(1), err)
+		e.Platform = p.name
+		return 0, e
+	}
+	switch {
+	case r < 0:
+		return 1, nil
+	case r > 0:
+		return 2, nil
+	default:
+		return exitOK, nil
+	}
+}
+
+func runSort(args []string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	f := newFlags("sort", stderr, true)
+	reverse := f.Bool("r", false, "sort in descending order")
+	unique := f.Bool("u", false, "output only the first of equal versions")
+	p, err := f.parse(args, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var vs []version
+	s := bufio.NewScanner(stdin)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" {
+			continue
+		}
+		v, err := f.version(p, text)
+		if err != nil {
+			e := err.(*cliError)
+			e.Line = line
+			return 0, e
+		}
+		vs = append(vs, v)
+	}
+	if err := s.Err(); err != nil {
+		return 0, newError(codeIO, "read input. err: %v", err)
+	}
+
+	collection.Sort(vs)
+	if *unique {
+		vs = collection.Dedup(vs)
+	}
+	if *reverse {
+		slices.Reverse(vs)
+	}
+
+	w := bufio.NewWriter(stdout)
+	for _, v := range vs {
+		fmt.Fprintln(w, v.original)
+	}
+	if err := w.Flush(); err != nil {
+		return 0, newError(codeIO, "write output. err: %v", err)
+	}
+	return exitOK, nil
+}
+
+func runCheck(args []string, stderr io.Writer) (int, error) {
+	f := newFlags("check", stderr, true)
+	p, err := f.parse(args, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := f.version(p, f.Arg(0))
+	if err != nil {
+		return 0, err
+	}
+	c, err := parseConstraint(p, f.Arg(1), f.options())
+	if err != nil {
+		var perr *parseerr.ParseError
+		if errors.As(err, &perr) {
+			return 0, newParseError(p, perr.Input, err)
+		}
+		return 0, newError(codeUsage, "%v", err)
+	}
+
+	if !c.check(v) {
+		return exitFalse, nil
+	}
+	return exitOK, nil
+}
+
+// runDetect writes the platforms that the version may belong to, one per line and the most likely first.
+// If the release catalog of any platform contains the version, only those platforms are written.
+func runDetect(args []string, stdout, stderr io.Writer) (int, error) {
+	f := newFlags("detect", stderr, false)
+	if _, err := f.parse(args, 1); err != nil {
+		return 0, err
+	}
+
+	var known, parsable []string
+	for _, p := range platforms {
+		v, err := p.parse(f.Arg(0), f.options())
+		if err != nil {
+			continue
+		}
+		if v.known {
+			known = append(known, p.name)
+		}
+		parsable = append(parsable, p.name)
+	}
+
+	ns := known
+	if len(ns) == 0 {
+		ns = parsable
+	}
+	if len(ns) == 0 {
+		e := newError(codeUndetected, "no platform accepts the version %q", f.Arg(0))
+		e.Input = f.Arg(0)
+		return 0, e
+	}
+	for _, n := range ns {
+		fmt.Fprintln(stdout, n)
+	}
+	return exitOK, nil
+}
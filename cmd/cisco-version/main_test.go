@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	type args struct {
+		args  []string
+		stdin string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name: "parse",
+			args: args{
+				args: []string{"parse", "-platform", "ios-xe", "17.9.4a"},
+			},
+			want:       0,
+			wantStdout: `{"platform":"ios-xe","input":"17.9.4a","version":"17.9.4a","canonical":"17.9.4a","train":"17.9","known":true,"fields":{"Release":"","Major":17,"Minor":9,"Maintenance":"4a"}}` + "\n",
+		},
+		{
+			name: "parse lenient",
+			args: args{
+				args: []string{"parse", "-platform", "ios-xe", "-lenient", "17.09.04a"},
+			},
+			want:       0,
+			wantStdout: `{"platform":"ios-xe","input":"17.09.04a","version":"17.9.4a","canonical":"17.9.4a","train":"17.9","known":true,"fields":{"Release":"","Major":17,"Minor":9,"Maintenance":"4a"}}` + "\n",
+		},
+		{
+			name: "parse error",
+			args: args{
+				args: []string{"parse", "-platform", "ios-xe", "17.x.4a"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"parse","message":"parse IOS XE minor version. expected: \"<number>\", actual: \"x\", err: strconv.Atoi: parsing \"x\": invalid syntax","platform":"ios-xe","input":"17.x.4a","field":"minor","offset":3,"expected":"<number>"}}` + "\n",
+		},
+		{
+			name: "unknown platform",
+			args: args{
+				args: []string{"parse", "-platform", "catos", "8.4(1)"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"usage","message":"unexpected platform. expected: [\"ios-xe\" \"ios\" \"nx-os\" \"ios-xr\" \"asa\" \"ftd\" \"fmc\" \"fxos\" \"wlc\" \"mds\"], actual: \"catos\""}}` + "\n",
+		},
+		{
+			name: "unknown command",
+			args: args{
+				args: []string{"diff"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"usage","message":"unexpected command. expected: [\"parse\" \"compare\" \"sort\" \"check\" \"detect\"], actual: \"diff\""}}` + "\n",
+		},
+		{
+			name: "compare equal",
+			args: args{
+				args: []string{"compare", "-platform", "nx-os", "9.3(8)", "9.3(8)"},
+			},
+			want: 0,
+		},
+		{
+			name: "compare less",
+			args: args{
+				args: []string{"compare", "-platform", "nx-os", "9.3(8)", "9.3(10)"},
+			},
+			want: 1,
+		},
+		{
+			name: "compare greater",
+			args: args{
+				args: []string{"compare", "-platform", "wlc", "8.10.196.0", "8.10.190.0"},
+			},
+			want: 2,
+		},
+		{
+			name: "compare incomparable",
+			args: args{
+				args: []string{"compare", "-platform", "ios", "15.2(4)M1", "15.2(4)E1"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"incomparable","message":"compare 15.2(4)M1 and 15.2(4)E1. err: cannot compare versions with different release types","platform":"ios"}}` + "\n",
+		},
+		{
+			name: "compare missing argument",
+			args: args{
+				args: []string{"compare", "-platform", "ios-xr", "7.9.2"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"usage","message":"unexpected number of arguments to compare. expected: 2, actual: 1"}}` + "\n",
+		},
+		{
+			name: "sort",
+			args: args{
+				args:  []string{"sort", "-platform", "asa"},
+				stdin: "9.18.3.56\n9.8.1\n\n9.18(3)56\n9.16.4\n",
+			},
+			want:       0,
+			wantStdout: "9.8.1\n9.16.4\n9.18.3.56\n9.18(3)56\n",
+		},
+		{
+			name: "sort -r -u",
+			args: args{
+				args:  []string{"sort", "-platform", "asa", "-r", "-u"},
+				stdin: "9.18.3.56\n9.8.1\n\n9.18(3)56\n9.16.4\n",
+			},
+			want:       0,
+			wantStdout: "9.18.3.56\n9.16.4\n9.8.1\n",
+		},
+		{
+			name: "sort parse error",
+			args: args{
+				args:  []string{"sort", "-platform", "ios-xr"},
+				stdin: "7.9.2\n7.x.1\n",
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"parse","message":"parse IOS XR minor version. expected: \"<number>\", actual: \"x\", err: strconv.Atoi: parsing \"x\": invalid syntax","platform":"ios-xr","input":"7.x.1","field":"minor","offset":2,"expected":"<number>","line":2}}` + "\n",
+		},
+		{
+			name: "check satisfied",
+			args: args{
+				args: []string{"check", "-platform", "ios-xe", "17.9.4a", ">=17.9.1, <17.9.5 || >=17.12.1"},
+			},
+			want: 0,
+		},
+		{
+			name: "check not satisfied",
+			args: args{
+				args: []string{"check", "-platform", "ios-xe", "17.9.5", ">=17.9.1, <17.9.5 || >=17.12.1"},
+			},
+			want: 1,
+		},
+		{
+			name: "detect nx-os",
+			args: args{
+				args: []string{"detect", "7.0(3)I7(9)"},
+			},
+			want:       0,
+			wantStdout: "nx-os\n",
+		},
+		{
+			name: "detect nx-os 9.3(8)",
+			args: args{
+				args: []string{"detect", "9.3(8)"},
+			},
+			want:       0,
+			wantStdout: "nx-os\n",
+		},
+		{
+			name: "detect mds 9.3(2a)",
+			args: args{
+				args: []string{"detect", "9.3(2a)"},
+			},
+			want:       0,
+			wantStdout: "mds\n",
+		},
+		{
+			name: "detect asa",
+			args: args{
+				args: []string{"detect", "9.18.3.56"},
+			},
+			want:       0,
+			wantStdout: "asa\n",
+		},
+		{
+			name: "detect undetected",
+			args: args{
+				args: []string{"detect", "catos"},
+			},
+			want:       3,
+			wantStderr: `{"error":{"code":"undetected","message":"no platform accepts the version \"catos\"","input":"catos"}}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if got := run(tt.args.args, strings.NewReader(tt.args.stdin), &stdout, &stderr); got != tt.want {
+				t.Errorf("run() = %v, want %v", got, tt.want)
+			}
+			if got := stdout.String(); got != tt.wantStdout {
+				t.Errorf("run() stdout = %v, want %v", got, tt.wantStdout)
+			}
+			if got := stderr.String(); got != tt.wantStderr {
+				t.Errorf("run() stderr = %v, want %v", got, tt.wantStderr)
+			}
+		})
+	}
+}
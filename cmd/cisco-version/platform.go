@@ -0,0 +1,95 @@
+package main
+
+import (
+	asa "github.com/MaineK00n/go-cisco-version/asa"
+	"github.com/MaineK00n/go-cisco-version/collection"
+	fmc "github.com/MaineK00n/go-cisco-version/fmc"
+	ftd "github.com/MaineK00n/go-cisco-version/ftd"
+	fxos "github.com/MaineK00n/go-cisco-version/fxos"
+	ios "github.com/MaineK00n/go-cisco-version/ios"
+	iosxe "github.com/MaineK00n/go-cisco-version/ios-xe"
+	iosxr "github.com/MaineK00n/go-cisco-version/ios-xr"
+	mds "github.com/MaineK00n/go-cisco-version/mds"
+	nxos "github.com/MaineK00n/go-cisco-version/nx-os"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+	wlc "github.com/MaineK00n/go-cisco-version/wlc"
+)
+
+// platform hides the Version type of a platform package behind the operations the subcommands need
+type platform struct {
+	name  string
+	parse func(s string, opts parseopt.Options) (version, error)
+}
+
+// typed is satisfied by the Version type of every platform package
+type typed interface {
+	collection.Version
+	Canonical() string
+}
+
+// version is a parsed version of any platform
+type version struct {
+	typed
+	// original is the version string as it was given to parse
+	original string
+	// compare compares with a version of the same platform
+	compare func(version) (int, error)
+	// object is the Object form of the version, which exposes its fields
+	object any
+	// known reports whether the version is in the release catalog of the platform
+	known bool
+}
+
+// platforms lists the supported platforms in the order detect prefers them
+var platforms = []platform{
+	newPlatform("ios-xe", iosxe.NewVersion, iosxe.Version.Compare, func(v iosxe.Version) any { return iosxe.Object(v) }, iosxe.Known),
+	newPlatform("ios", ios.NewVersion, ios.Version.Compare, func(v ios.Version) any { return ios.Object(v) }, ios.Known),
+	newPlatform("nx-os", nxos.NewVersion, nxos.Version.Compare, func(v nxos.Version) any { return nxos.Object(v) }, nxos.Known),
+	newPlatform("ios-xr", iosxr.NewVersion, infallible(iosxr.Version.Compare), func(v iosxr.Version) any { return iosxr.Object(v) }, iosxr.Known),
+	newPlatform("asa", asa.NewVersion, infallible(asa.Version.Compare), func(v asa.Version) any { return asa.Object(v) }, asa.Known),
+	newPlatform("ftd", ftd.NewVersion, infallible(ftd.Version.Compare), func(v ftd.Version) any { return ftd.Object(v) }, ftd.Known),
+	newPlatform("fmc", fmc.NewVersion, infallible(fmc.Version.Compare), func(v fmc.Version) any { return fmc.Object(v) }, fmc.Known),
+	newPlatform("fxos", fxos.NewVersion, infallible(fxos.Version.Compare), func(v fxos.Version) any { return fxos.Object(v) }, fxos.Known),
+	newPlatform("wlc", wlc.NewVersion, infallible(wlc.Version.Compare), func(v wlc.Version) any { return wlc.Object(v) }, wlc.Known),
+	newPlatform("mds", mds.NewVersion, infallible(mds.Version.Compare), func(v mds.Version) any { return mds.Object(v) }, mds.Known),
+}
+
+func newPlatform[V typed](name string, parse func(string, ...parseopt.Options) (V, error), compare func(V, V) (int, error), object func(V) any, known func(V) bool) platform {
+	return platform{
+		name: name,
+		parse: func(s string, opts parseopt.Options) (version, error) {
+			v, err := parse(s, opts)
+			if err != nil {
+				return version{}, err
+			}
+			return version{
+				typed:    v,
+				original: s,
+				compare:  func(w version) (int, error) { return compare(v, w.typed.(V)) },
+				object:   object(v),
+				known:    known(v),
+			}, nil
+		},
+	}
+}
+
+func infallible[V any](compare func(V, V) int) func(V, V) (int, error) {
+	return func(a, b V) (int, error) { return compare(a, b), nil }
+}
+
+func lookupPlatform(name string) (platform, bool) {
+	for _, p := range platforms {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return platform{}, false
+}
+
+func platformNames() []string {
+	ns := make([]string, 0, len(platforms))
+	for _, p := range platforms {
+		ns = append(ns, p.name)
+	}
+	return ns
+}
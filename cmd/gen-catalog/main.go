@@ -0,0 +1,194 @@
+// Command gen-catalog regenerates the embedded release catalog of a platform package
+// from locally saved Cisco release notes exports in HTML or JSON.
+//
+// Every token of the exports that parses as a version of the platform is a candidate,
+// so review the diff of the generated catalog before committing it.
+//
+//	go run ./cmd/gen-catalog -platform ios-xe -merge ios-xe/releases.json -o ios-xe/releases.json exports/*.html exports/*.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	asa "github.com/MaineK00n/go-cisco-version/asa"
+	"github.com/MaineK00n/go-cisco-version/collection"
+	fmc "github.com/MaineK00n/go-cisco-version/fmc"
+	ftd "github.com/MaineK00n/go-cisco-version/ftd"
+	fxos "github.com/MaineK00n/go-cisco-version/fxos"
+	"github.com/MaineK00n/go-cisco-version/internal/catalog"
+	ios "github.com/MaineK00n/go-cisco-version/ios"
+	iosxe "github.com/MaineK00n/go-cisco-version/ios-xe"
+	iosxr "github.com/MaineK00n/go-cisco-version/ios-xr"
+	mds "github.com/MaineK00n/go-cisco-version/mds"
+	nxos "github.com/MaineK00n/go-cisco-version/nx-os"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+	wlc "github.com/MaineK00n/go-cisco-version/wlc"
+)
+
+const defaultPattern = `[0-9A-Za-z][0-9A-Za-z.()-]*[0-9A-Za-z)]`
+
+var tagPattern = regexp.MustCompile(`(?s)<script.*?</script>|<style.*?</style>|<[^>]*>`)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "gen-catalog: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("gen-catalog", flag.ContinueOnError)
+	platform := fs.String("platform", "", "platform package: asa, fmc, ftd, fxos, ios, ios-xe, ios-xr, mds, nx-os, wlc")
+	version := fs.String("version", time.Now().UTC().Format("2006-01-02"), "catalog version")
+	merge := fs.String("merge", "", "existing catalog whose releases are kept")
+	output := fs.String("o", "", "output file (default: stdout)")
+	pattern := fs.String("pattern", defaultPattern, "regular expression matching candidate version strings")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	re, err := regexp.Compile(*pattern)
+	if err != nil {
+		return fmt.Errorf("compile pattern. err: %w", err)
+	}
+
+	var candidates []string
+	if *merge != "" {
+		bs, err := os.ReadFile(*merge)
+		if err != nil {
+			return fmt.Errorf("read %s. err: %w", *merge, err)
+		}
+		var f catalog.File
+		if err := json.Unmarshal(bs, &f); err != nil {
+			return fmt.Errorf("unmarshal %s. err: %w", *merge, err)
+		}
+		candidates = append(candidates, f.Releases...)
+	}
+	for _, name := range fs.Args() {
+		bs, err := os.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("read %s. err: %w", name, err)
+		}
+		cs, err := extract(filepath.Ext(name), bs, re)
+		if err != nil {
+			return fmt.Errorf("extract %s. err: %w", name, err)
+		}
+		candidates = append(candidates, cs...)
+	}
+
+	rs, err := generate(*platform, candidates)
+	if err != nil {
+		return err
+	}
+
+	bs, err := json.MarshalIndent(catalog.File{Version: *version, Releases: rs}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal catalog. err: %w", err)
+	}
+	bs = append(bs, '\n')
+
+	if *output == "" {
+		_, err := stdout.Write(bs)
+		return err
+	}
+	if err := os.WriteFile(*output, bs, 0644); err != nil {
+		return fmt.Errorf("write %s. err: %w", *output, err)
+	}
+	return nil
+}
+
+// extract returns the tokens matching re in the text of an HTML export or in the strings of a JSON export
+func extract(ext string, data []byte, re *regexp.Regexp) ([]string, error) {
+	switch strings.ToLower(ext) {
+	case ".json":
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("unmarshal json. err: %w", err)
+		}
+		var cs []string
+		var walk func(any)
+		walk = func(v any) {
+			switch v := v.(type) {
+			case string:
+				cs = append(cs, re.FindAllString(v, -1)...)
+			case []any:
+				for _, e := range v {
+					walk(e)
+				}
+			case map[string]any:
+				for _, e := range v {
+					walk(e)
+				}
+			}
+		}
+		walk(v)
+		return cs, nil
+	case ".html", ".htm":
+		return re.FindAllString(html.UnescapeString(tagPattern.ReplaceAllString(string(data), " ")), -1), nil
+	default:
+		return re.FindAllString(string(data), -1), nil
+	}
+}
+
+// generate returns the candidates that parse as versions of the platform, deduplicated and in ascending order.
+// The first form seen of a release is kept, with lenient normalization applied.
+func generate(platform string, candidates []string) ([]string, error) {
+	switch platform {
+	case "asa":
+		return releases(candidates, asa.NewVersion), nil
+	case "fmc":
+		return releases(candidates, fmc.NewVersion), nil
+	case "ftd":
+		return releases(candidates, ftd.NewVersion), nil
+	case "fxos":
+		return releases(candidates, fxos.NewVersion), nil
+	case "ios":
+		return releases(candidates, ios.NewVersion), nil
+	case "ios-xe":
+		return releases(candidates, iosxe.NewVersion), nil
+	case "ios-xr":
+		return releases(candidates, iosxr.NewVersion), nil
+	case "mds":
+		return releases(candidates, mds.NewVersion), nil
+	case "nx-os":
+		return releases(candidates, nxos.NewVersion), nil
+	case "wlc":
+		return releases(candidates, wlc.NewVersion), nil
+	default:
+		return nil, fmt.Errorf("unexpected platform. expected: %q, actual: %q", []string{"asa", "fmc", "ftd", "fxos", "ios", "ios-xe", "ios-xr", "mds", "nx-os", "wlc"}, platform)
+	}
+}
+
+func releases[V collection.Version](candidates []string, parse func(string, ...parseopt.Options) (V, error)) []string {
+	normalized := make(map[string]string)
+	var vs []V
+	for _, c := range candidates {
+		if !strings.Contains(c, ".") {
+			continue
+		}
+		var r parseopt.Report
+		v, err := parse(c, parseopt.Options{Mode: parseopt.Lenient, Report: &r})
+		if err != nil {
+			continue
+		}
+		if _, ok := normalized[v.SortKey()]; !ok {
+			normalized[v.SortKey()] = r.Normalized
+		}
+		vs = append(vs, v)
+	}
+
+	var rs []string
+	for _, v := range collection.Dedup(collection.Sorted(vs)) {
+		rs = append(rs, normalized[v.SortKey()])
+	}
+	return rs
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	type args struct {
+		ext  string
+		data string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "html",
+			args: args{
+				ext:  ".html",
+				data: `<html><head><style>p{margin:0.5em}</style></head><body><p>Cisco&nbsp;IOS&nbsp;XE <b>17.9.4a</b></p><td>17.09.05</td></body></html>`,
+			},
+			want: []string{"17.09.05", "17.9.4a", "Cisco", "IOS", "XE"},
+		},
+		{
+			name: "json",
+			args: args{
+				ext:  ".json",
+				data: `{"releases": [{"name": "17.9.4a"}, {"name": "17.12.1", "date": 20231201}]}`,
+			},
+			want: []string{"17.12.1", "17.9.4a"},
+		},
+		{
+			name: "broken json",
+			args: args{
+				ext:  ".json",
+				data: `{"releases": [`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extract(tt.args.ext, []byte(tt.args.data), regexp.MustCompile(defaultPattern))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("extract() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			slices.Sort(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extract() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	type args struct {
+		platform   string
+		candidates []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "ios-xe",
+			args: args{
+				platform:   "ios-xe",
+				candidates: []string{"17.12.1", "Cisco", "17.09.04a", "17.9.4a", "3.16.10S", "16.12.5", "1.0"},
+			},
+			want: []string{"3.16.10S", "16.12.5", "17.9.4a", "17.12.1"},
+		},
+		{
+			name: "asa",
+			args: args{
+				platform:   "asa",
+				candidates: []string{"9.18(3)56", "9.18.3.56", "9.16.4", "v9.8.1"},
+			},
+			want: []string{"9.8.1", "9.16.4", "9.18(3)56"},
+		},
+		{
+			name: "unknown platform",
+			args: args{
+				platform: "catos",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := generate(tt.args.platform, tt.args.candidates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("generate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "releases.json"), []byte(`{"version": "2026-01-01", "releases": ["7.9.2"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.html"), []byte(`<p>Release 7.11.1 and 24.1.1 (GISO 7.9.2)</p>`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := run([]string{"-platform", "ios-xr", "-version", "2026-10-19", "-merge", filepath.Join(dir, "releases.json"), filepath.Join(dir, "notes.html")}, &buf); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	want := `{
+  "version": "2026-10-19",
+  "releases": [
+    "7.9.2",
+    "7.11.1",
+    "24.1.1"
+  ]
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("run() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Package collection provides operations on slices of the Version type of any platform package
+package collection
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Version is satisfied by the Version type of every platform package
+type Version interface {
+	String() string
+	SortKey() string
+	Train() string
+}
+
+func compare[V Version](a, b V) int {
+	return cmp.Compare(a.SortKey(), b.SortKey())
+}
+
+// Sort sorts vs in ascending order, keeping the order of equal versions.
+// Versions are grouped by train, and the trains are ordered by their smallest version,
+// so versions that the platform Compare cannot order, such as different IOS releases, are not interleaved, e.g. 15.0(1)M < 15.0(2)M < 15.0(1)T.
+func Sort[V Version](vs []V) {
+	first := make(map[string]string)
+	for _, v := range vs {
+		if k, ok := first[v.Train()]; !ok || v.SortKey() < k {
+			first[v.Train()] = v.SortKey()
+		}
+	}
+	slices.SortStableFunc(vs, func(a, b V) int {
+		return cmp.Or(
+			cmp.Compare(first[a.Train()], first[b.Train()]),
+			cmp.Compare(a.Train(), b.Train()),
+			compare(a, b),
+		)
+	})
+}
+
+// Sorted returns a sorted copy of vs
+func Sorted[V Version](vs []V) []V {
+	s := slices.Clone(vs)
+	Sort(s)
+	return s
+}
+
+// Dedup returns vs without versions equal to an earlier one, e.g. "16.5.1" after "Everest-16.5.1"
+func Dedup[V Version](vs []V) []V {
+	seen := make(map[string]struct{}, len(vs))
+	var ds []V
+	for _, v := range vs {
+		if _, ok := seen[v.SortKey()]; ok {
+			continue
+		}
+		seen[v.SortKey()] = struct{}{}
+		ds = append(ds, v)
+	}
+	return ds
+}
+
+// Min returns the smallest version in vs, or false if vs is empty
+func Min[V Version](vs []V) (V, bool) {
+	if len(vs) == 0 {
+		var zero V
+		return zero, false
+	}
+	return slices.MinFunc(vs, compare), true
+}
+
+// Max returns the largest version in vs, or false if vs is empty
+func Max[V Version](vs []V) (V, bool) {
+	if len(vs) == 0 {
+		var zero V
+		return zero, false
+	}
+	return slices.MaxFunc(vs, compare), true
+}
+
+// GroupBy returns vs grouped by key, each group keeping the order of vs
+func GroupBy[V Version, K comparable](vs []V, key func(V) K) map[K][]V {
+	m := make(map[K][]V)
+	for _, v := range vs {
+		k := key(v)
+		m[k] = append(m[k], v)
+	}
+	return m
+}
+
+// GroupByTrain returns vs grouped by release train
+func GroupByTrain[V Version](vs []V) map[string][]V {
+	return GroupBy(vs, V.Train)
+}
+
+// LatestByTrain returns the largest version of each release train in vs
+func LatestByTrain[V Version](vs []V) map[string]V {
+	m := make(map[string]V)
+	for train, g := range GroupByTrain(vs) {
+		m[train], _ = Max(g)
+	}
+	return m
+}
@@ -0,0 +1,167 @@
+package collection_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	asa "github.com/MaineK00n/go-cisco-version/asa"
+	"github.com/MaineK00n/go-cisco-version/collection"
+	ios "github.com/MaineK00n/go-cisco-version/ios"
+	iosxe "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func parseIOS(t *testing.T, ss ...string) []ios.Version {
+	t.Helper()
+	vs := make([]ios.Version, 0, len(ss))
+	for _, s := range ss {
+		v, err := ios.NewVersion(s)
+		if err != nil {
+			t.Fatalf("ios.NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func parseIOSXE(t *testing.T, ss ...string) []iosxe.Version {
+	t.Helper()
+	vs := make([]iosxe.Version, 0, len(ss))
+	for _, s := range ss {
+		v, err := iosxe.NewVersion(s)
+		if err != nil {
+			t.Fatalf("iosxe.NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func strs[V fmt.Stringer](vs []V) []string {
+	ss := make([]string, 0, len(vs))
+	for _, v := range vs {
+		ss = append(ss, v.String())
+	}
+	return ss
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   []string
+		want []string
+	}{
+		{
+			name: "numeric-aware",
+			vs:   []string{"15.2(4)M10", "15.2(4)M9", "12.4(25f)", "15.2(4)M9a"},
+			want: []string{"12.4(25f)", "15.2(4)M9", "15.2(4)M9a", "15.2(4)M10"},
+		},
+		{
+			name: "incomparable releases",
+			vs:   []string{"15.0(1)T", "15.0(2)M", "15.0(1)M1", "15.0(1)M"},
+			want: []string{"15.0(1)M", "15.0(1)M1", "15.0(2)M", "15.0(1)T"},
+		},
+		{
+			name: "trains ordered by their smallest version",
+			vs:   []string{"12.4(24)T8", "12.4(25f)", "12.4(3)", "12.4(15)T1"},
+			want: []string{"12.4(3)", "12.4(25f)", "12.4(15)T1", "12.4(24)T8"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := parseIOS(t, tt.vs...)
+			collection.Sort(vs)
+			if got := strs(vs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSorted(t *testing.T) {
+	vs := parseIOSXE(t, "17.9.10", "17.9.4a", "Everest-16.5.1")
+	got := collection.Sorted(vs)
+	if want := []string{"Everest-16.5.1", "17.9.4a", "17.9.10"}; !reflect.DeepEqual(strs(got), want) {
+		t.Errorf("Sorted() = %v, want %v", strs(got), want)
+	}
+	if want := []string{"17.9.10", "17.9.4a", "Everest-16.5.1"}; !reflect.DeepEqual(strs(vs), want) {
+		t.Errorf("Sorted() modified its input: %v", strs(vs))
+	}
+}
+
+func TestDedup(t *testing.T) {
+	vs := parseIOSXE(t, "Everest-16.5.1", "17.09.04a", "16.5.1", "17.9.4a", "17.9.4")
+	if got, want := strs(collection.Dedup(vs)), []string{"Everest-16.5.1", "17.9.04a", "17.9.4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dedup() = %v, want %v", got, want)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	vs := []asa.Version{
+		{Major: 9, Minor: 18, Maintenance: 3, Vulnerability: 56},
+		{Major: 9, Minor: 8, Maintenance: 4},
+		{Major: 9, Minor: 20, Maintenance: 1},
+	}
+
+	if got, ok := collection.Min(vs); !ok || got != vs[1] {
+		t.Errorf("Min() = (%v, %v), want (%v, true)", got, ok, vs[1])
+	}
+	if got, ok := collection.Max(vs); !ok || got != vs[2] {
+		t.Errorf("Max() = (%v, %v), want (%v, true)", got, ok, vs[2])
+	}
+	if _, ok := collection.Max([]asa.Version(nil)); ok {
+		t.Errorf("Max(nil) ok = true, want false")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	vs := parseIOS(t, "15.2(4)M10", "15.2(7)E8", "12.4(24)T8", "15.2(4)M9")
+	got := collection.GroupBy(vs, func(v ios.Version) int { return v.Major })
+	want := map[int][]string{
+		12: {"12.4(24)T8"},
+		15: {"15.2(4)M10", "15.2(7)E8", "15.2(4)M9"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GroupBy() = %v, want %v", got, want)
+	}
+	for k, g := range got {
+		if !reflect.DeepEqual(strs(g), want[k]) {
+			t.Errorf("GroupBy()[%d] = %v, want %v", k, strs(g), want[k])
+		}
+	}
+}
+
+func TestGroupByTrain(t *testing.T) {
+	vs := parseIOS(t, "15.2(4)M10", "15.2(7)E8", "15.2(4)M9")
+	got := collection.GroupByTrain(vs)
+	want := map[string][]string{
+		"15.2M": {"15.2(4)M10", "15.2(4)M9"},
+		"15.2E": {"15.2(7)E8"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GroupByTrain() = %v, want %v", got, want)
+	}
+	for k, g := range got {
+		if !reflect.DeepEqual(strs(g), want[k]) {
+			t.Errorf("GroupByTrain()[%s] = %v, want %v", k, strs(g), want[k])
+		}
+	}
+}
+
+func TestLatestByTrain(t *testing.T) {
+	vs := parseIOSXE(t, "17.9.4a", "17.9.10", "17.6.5", "3.16.8S", "3.16.10S", "17.6.4")
+	got := collection.LatestByTrain(vs)
+	want := map[string]string{
+		"17.9":  "17.9.10",
+		"17.6":  "17.6.5",
+		"3.16S": "3.16.10S",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("LatestByTrain() = %v, want %v", got, want)
+	}
+	for k, v := range got {
+		if v.String() != want[k] {
+			t.Errorf("LatestByTrain()[%s] = %v, want %v", k, v.String(), want[k])
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package version_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/fmc"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// seeds are the inputs of the table tests and the malformed inputs that the parser has to reject without panicking
+var seeds = []string{
+	"7",
+	"7.0",
+	"7.0(1)",
+	"7.0(1)1",
+	"7.0(1)1a",
+	"7.0(1.1)",
+	"7.0.1",
+	"7.0.1.1",
+	"7.2.5",
+	"7.2.5.1",
+	"7.2.x",
+	"Version 7.2.5",
+	"7.2.",
+	"7.2()",
+}
+
+// compare returns the result of v1.Compare(v2). The versions can always be compared.
+func compare(v1, v2 version.Version) (int, bool) {
+	return v1.Compare(v2), true
+}
+
+func FuzzNewVersion(f *testing.F) {
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		// lenient parsing only has to terminate without panicking
+		_, _ = version.NewVersion(s, parseopt.Options{Mode: parseopt.Lenient})
+
+		v, err := version.NewVersion(s)
+		if err != nil {
+			return
+		}
+		got, err := version.NewVersion(v.String())
+		if err != nil {
+			t.Fatalf("NewVersion(%q) error = %v, parsed from %q", v.String(), err, s)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("NewVersion(%q) = %#v, want %#v", v.String(), got, v)
+		}
+	})
+}
+
+func FuzzVersion_Compare(f *testing.F) {
+	for i := range seeds {
+		f.Add(seeds[i], seeds[(i+1)%len(seeds)], seeds[(i+2)%len(seeds)])
+	}
+	f.Fuzz(func(t *testing.T, s1, s2, s3 string) {
+		var vs []version.Version
+		for _, s := range []string{s1, s2, s3} {
+			v, err := version.NewVersion(s)
+			if err != nil {
+				t.Skip()
+			}
+			vs = append(vs, v)
+		}
+		v1, v2, v3 := vs[0], vs[1], vs[2]
+
+		r12, ok12 := compare(v1, v2)
+		r21, ok21 := compare(v2, v1)
+		if ok12 != ok21 || r12 != -r21 {
+			t.Fatalf("%q.Compare(%q) = %d, %v but %q.Compare(%q) = %d, %v", s1, s2, r12, ok12, s2, s1, r21, ok21)
+		}
+		if ok12 && cmp.Compare(v1.SortKey(), v2.SortKey()) != r12 {
+			t.Errorf("%q.Compare(%q) = %d, but the sort keys compare %d", s1, s2, r12, cmp.Compare(v1.SortKey(), v2.SortKey()))
+		}
+
+		r23, ok23 := compare(v2, v3)
+		r13, ok13 := compare(v1, v3)
+		if ok12 && ok23 && ok13 && r12 <= 0 && r23 <= 0 && r13 > 0 {
+			t.Errorf("%q <= %q and %q <= %q, but %q > %q", s1, s2, s2, s3, s1, s3)
+		}
+	})
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 // Version represents an Cisco Firepower Management Center (FMC) version
@@ -16,50 +20,98 @@ type Version struct {
 	Vulnerability int
 }
 
+const platformName = "FMC"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat        = parseerr.FieldFormat
+	FieldMajor         = parseerr.FieldMajor
+	FieldMinor         = parseerr.FieldMinor
+	FieldMaintenance   = parseerr.FieldMaintenance
+	FieldVulnerability = parseerr.FieldVulnerability
+)
+
 // NewVersion returns a parsed version
-func NewVersion(ver string) (Version, error) {
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, nil)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+func parse(ver string) (Version, error) {
 	switch ss := strings.Split(strings.TrimSuffix(strings.NewReplacer("(", ".", ")", ".").Replace(ver), "."), "."); len(ss) {
 	case 3:
 		major, err := strconv.Atoi(ss[0])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse major version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: parseerr.Offset(ss, 0), Length: len(ss[0]), Expected: "<number>", Err: err}
 		}
 
 		minor, err := strconv.Atoi(ss[1])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: parseerr.Offset(ss, 1), Length: len(ss[1]), Expected: "<number>", Err: err}
 		}
 
 		maintenance, err := strconv.Atoi(ss[2])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse maintenance version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMaintenance, Offset: parseerr.Offset(ss, 2), Length: len(ss[2]), Expected: "<number>", Err: err}
 		}
 
 		return Version{Major: major, Minor: minor, Maintenance: maintenance}, nil
 	case 4:
 		major, err := strconv.Atoi(ss[0])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse major version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: parseerr.Offset(ss, 0), Length: len(ss[0]), Expected: "<number>", Err: err}
 		}
 
 		minor, err := strconv.Atoi(ss[1])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: parseerr.Offset(ss, 1), Length: len(ss[1]), Expected: "<number>", Err: err}
 		}
 
 		maintenance, err := strconv.Atoi(ss[2])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse maintenance version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMaintenance, Offset: parseerr.Offset(ss, 2), Length: len(ss[2]), Expected: "<number>", Err: err}
 		}
 
 		vulnerability, err := strconv.Atoi(ss[3])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse vulnerability version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldVulnerability, Offset: parseerr.Offset(ss, 3), Length: len(ss[3]), Expected: "<number>", Err: err}
 		}
 
 		return Version{Major: major, Minor: minor, Maintenance: maintenance, Vulnerability: vulnerability}, nil
 	default:
-		return Version{}, fmt.Errorf("unexpected FMC version format. expected: %q, actual: %q", "<major>.<minor>.<maintenance>(.<vulnerability>)", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>.<maintenance>(.<vulnerability>)"}
 	}
 }
 
@@ -78,3 +130,13 @@ func (v1 Version) Compare(v2 Version) int {
 func (v Version) String() string {
 	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Maintenance, v.Vulnerability)
 }
+
+// Train returns the release train, e.g. "7.2" for 7.2.5.1
+func (v Version) Train() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Canonical returns the full version string
+func (v Version) Canonical() string {
+	return v.String()
+}
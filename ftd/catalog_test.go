@@ -0,0 +1,208 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ftd"
+)
+
+func TestVersion_Next(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "7.2.5",
+			args:   args{ver: "7.2.5"},
+			want:   "7.2.6.0",
+			wantOK: true,
+		},
+		{
+			name:   "7.2.5.1",
+			args:   args{ver: "7.2.5.1"},
+			want:   "7.2.6.0",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Next()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Next() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_Prev(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "7.2.5",
+			args:   args{ver: "7.2.5"},
+			want:   "7.2.4.0",
+			wantOK: true,
+		},
+		{
+			name:   "7.2.5.1",
+			args:   args{ver: "7.2.5.1"},
+			want:   "7.2.5.0",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Prev()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Prev() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMaintenance(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "7.2.5",
+			args:   args{ver: "7.2.5"},
+			want:   "7.2.6.0",
+			wantOK: true,
+		},
+		{
+			name:   "7.2.5.1",
+			args:   args{ver: "7.2.5.1"},
+			want:   "7.2.6.0",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMaintenance()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMaintenance() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMinor(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "7.2.5",
+			args:   args{ver: "7.2.5"},
+			want:   "7.3.0.0",
+			wantOK: true,
+		},
+		{
+			name:   "7.2.5.1",
+			args:   args{ver: "7.2.5.1"},
+			want:   "7.3.0.0",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMinor()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMinor() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestKnown(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "7.2.5",
+			args: args{ver: "7.2.5"},
+			want: true,
+		},
+		{
+			name: "7.2.5.0",
+			args: args{ver: "7.2.5.0"},
+			want: true,
+		},
+		{
+			name: "7.2.9",
+			args: args{ver: "7.2.9"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := version.Known(v); got != tt.want {
+				t.Errorf("Known() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleases(t *testing.T) {
+	rs := version.Releases()
+	if len(rs) == 0 {
+		t.Fatalf("Releases() is empty")
+	}
+	for i := 1; i < len(rs); i++ {
+		if rs[i-1].SortKey() >= rs[i].SortKey() {
+			t.Errorf("Releases() is not in ascending order: %v >= %v", rs[i-1], rs[i])
+		}
+	}
+	if version.CatalogVersion() == "" {
+		t.Errorf("CatalogVersion() is empty")
+	}
+}
@@ -0,0 +1,35 @@
+package version
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "7.2.5" and "7.2.5.0", have the same Key.
+type Key struct {
+	Major         int
+	Minor         int
+	Maintenance   int
+	Vulnerability int
+}
+
+// Key returns the key of the version
+func (v Version) Key() Key {
+	return Key{
+		Major:         v.Major,
+		Minor:         v.Minor,
+		Maintenance:   v.Maintenance,
+		Vulnerability: v.Vulnerability,
+	}
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Major:         k.Major,
+		Minor:         k.Minor,
+		Maintenance:   k.Maintenance,
+		Vulnerability: k.Vulnerability,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
@@ -0,0 +1,78 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ftd"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "7.2.5 == 7.2(5)",
+			args: args{
+				v1: "7.2.5",
+				v2: "7.2(5)",
+			},
+			want: true,
+		},
+		{
+			name: "7.2.5 == 7.2.5.0",
+			args: args{
+				v1: "7.2.5",
+				v2: "7.2.5.0",
+			},
+			want: true,
+		},
+		{
+			name: "7.2.5 != 7.2.5.1",
+			args: args{
+				v1: "7.2.5",
+				v2: "7.2.5.1",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("7.2.5")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "7.2.5"}
+	if got := m[version.Version{Major: 7, Minor: 2, Maintenance: 5}.Key()]; got != "7.2.5" {
+		t.Errorf("map[Key] = %q, want %q", got, "7.2.5")
+	}
+	if got := v.Key().Version(); got != v {
+		t.Errorf("Key.Version() = %v, want %v", got, v)
+	}
+}
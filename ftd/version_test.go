@@ -1,15 +1,18 @@
 package version_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	version "github.com/MaineK00n/go-cisco-version/ftd"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 func TestNewVersion(t *testing.T) {
 	type args struct {
-		ver string
+		ver  string
+		opts []parseopt.Options
 	}
 	tests := []struct {
 		name    string
@@ -52,10 +55,15 @@ func TestNewVersion(t *testing.T) {
 			args:    args{ver: "7.0(1)1a"},
 			wantErr: true,
 		},
+		{
+			name: "lenient Version 7.2.5",
+			args: args{ver: "Version 7.2.5", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			want: version.Version{Major: 7, Minor: 2, Maintenance: 5},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := version.NewVersion(tt.args.ver)
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -67,6 +75,46 @@ func TestNewVersion(t *testing.T) {
 	}
 }
 
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "7.2.x",
+			args:       args{ver: "7.2.x"},
+			wantField:  "maintenance",
+			wantOffset: 4,
+			wantLength: 1,
+		},
+		{
+			name:       "7",
+			args:       args{ver: "7"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
 func TestVersion_Compare(t *testing.T) {
 	type fields struct {
 		Major         int
@@ -160,3 +208,74 @@ func TestVersion_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "7.2.5",
+			args:          args{ver: "7.2.5"},
+			wantOriginal:  "7.2.5",
+			wantCanonical: "7.2.5.0",
+		},
+		{
+			name:          "7.0(1)1",
+			args:          args{ver: "7.0(1)1"},
+			wantOriginal:  "7.0(1)1",
+			wantCanonical: "7.0.1.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Train(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "7.2.5.1",
+			args: args{ver: "7.2.5.1"},
+			want: "7.2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
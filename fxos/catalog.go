@@ -0,0 +1,57 @@
+package version
+
+import (
+	_ "embed"
+	"slices"
+	"sync"
+
+	"github.com/MaineK00n/go-cisco-version/internal/catalog"
+)
+
+//go:embed releases.json
+var releasesJSON []byte
+
+var releases = sync.OnceValue(func() catalog.Catalog[Version] {
+	return catalog.Load(releasesJSON, func(s string) (Version, error) { return NewVersion(s) })
+})
+
+// CatalogVersion returns the version of the embedded release catalog
+func CatalogVersion() string {
+	return releases().Version
+}
+
+// Releases returns the releases in the embedded release catalog in ascending order
+func Releases() []Version {
+	return slices.Clone(releases().Releases)
+}
+
+// Known reports whether v is a release in the embedded release catalog
+func Known(v Version) bool {
+	return catalog.Known(releases().Releases, v)
+}
+
+func compare(v1, v2 Version) (int, error) {
+	return v1.Compare(v2), nil
+}
+
+// Next returns the release following v on the same train in the release catalog
+func (v Version) Next() (Version, bool) {
+	return catalog.Next(releases().Releases, v, compare, func(r Version) bool { return r.Train() == v.Train() })
+}
+
+// Prev returns the release preceding v on the same train in the release catalog
+func (v Version) Prev() (Version, bool) {
+	return catalog.Prev(releases().Releases, v, compare, func(r Version) bool { return r.Train() == v.Train() })
+}
+
+// NextMaintenance returns the next maintenance release on the same train in the release catalog, skipping builds of v, e.g. 2.12(0.498) for 2.12(0.31)
+func (v Version) NextMaintenance() (Version, bool) {
+	return catalog.Next(releases().Releases, v, compare, func(r Version) bool {
+		return r.Train() == v.Train() && (r.Maintenance > v.Maintenance || r.Vulnerability > v.Vulnerability)
+	})
+}
+
+// NextMinor returns the first release of the next train in the release catalog, e.g. 2.11(1.154) for 2.10(1.159)
+func (v Version) NextMinor() (Version, bool) {
+	return catalog.Next(releases().Releases, v, compare, func(r Version) bool { return r.Train() != v.Train() })
+}
@@ -0,0 +1,78 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/fxos"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "2.10(1.159) == 2.10.1.159",
+			args: args{
+				v1: "2.10(1.159)",
+				v2: "2.10.1.159",
+			},
+			want: true,
+		},
+		{
+			name: "2.10(1.159) == 2.10(1)159",
+			args: args{
+				v1: "2.10(1.159)",
+				v2: "2.10(1)159",
+			},
+			want: true,
+		},
+		{
+			name: "2.10(1.159) != 2.10(1.179)",
+			args: args{
+				v1: "2.10(1.159)",
+				v2: "2.10(1.179)",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("2.10(1.159)")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "2.10(1.159)"}
+	if got := m[version.Version{Major: 2, Minor: 10, Maintenance: 1, Vulnerability: 159}.Key()]; got != "2.10(1.159)" {
+		t.Errorf("map[Key] = %q, want %q", got, "2.10(1.159)")
+	}
+	if got := v.Key().Version(); got != v {
+		t.Errorf("Key.Version() = %v, want %v", got, v)
+	}
+}
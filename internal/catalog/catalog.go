@@ -0,0 +1,71 @@
+// Package catalog loads the embedded release catalogs of the platform packages
+package catalog
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// File represents the release catalog data file of a platform
+type File struct {
+	Version  string   `json:"version"`
+	Releases []string `json:"releases"`
+}
+
+// Catalog represents a loaded release catalog
+type Catalog[V any] struct {
+	Version  string
+	Releases []V
+}
+
+// Load returns the catalog in data, with the releases sorted by their sort key.
+// It panics if data is broken, since catalogs are embedded at build time.
+func Load[V interface{ SortKey() string }](data []byte, parse func(string) (V, error)) Catalog[V] {
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		panic(fmt.Sprintf("unmarshal release catalog. err: %v", err))
+	}
+
+	rs := make([]V, 0, len(f.Releases))
+	for _, s := range f.Releases {
+		r, err := parse(s)
+		if err != nil {
+			panic(fmt.Sprintf("parse release %q in catalog. err: %v", s, err))
+		}
+		rs = append(rs, r)
+	}
+	slices.SortStableFunc(rs, func(a, b V) int { return cmp.Compare(a.SortKey(), b.SortKey()) })
+	return Catalog[V]{Version: f.Version, Releases: rs}
+}
+
+// Known reports whether releases, sorted by their sort key, contain a release equal to v
+func Known[V interface{ SortKey() string }](releases []V, v V) bool {
+	_, found := slices.BinarySearchFunc(releases, v.SortKey(), func(r V, k string) int { return cmp.Compare(r.SortKey(), k) })
+	return found
+}
+
+// Next returns the smallest release greater than v that satisfies match.
+// Releases that compare cannot order against v are skipped.
+func Next[V any](releases []V, v V, compare func(V, V) (int, error), match func(V) bool) (V, bool) {
+	for _, r := range releases {
+		if c, err := compare(r, v); err == nil && c > 0 && match(r) {
+			return r, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Prev returns the greatest release smaller than v that satisfies match.
+// Releases that compare cannot order against v are skipped.
+func Prev[V any](releases []V, v V, compare func(V, V) (int, error), match func(V) bool) (V, bool) {
+	for _, r := range slices.Backward(releases) {
+		if c, err := compare(r, v); err == nil && c < 0 && match(r) {
+			return r, true
+		}
+	}
+	var zero V
+	return zero, false
+}
@@ -0,0 +1,131 @@
+package catalog_test
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/internal/catalog"
+)
+
+type release int
+
+func (r release) SortKey() string {
+	return fmt.Sprintf("%08d", r)
+}
+
+func parse(s string) (release, error) {
+	n, err := strconv.Atoi(s)
+	return release(n), err
+}
+
+func compare(a, b release) (int, error) {
+	if a%2 != b%2 {
+		return 0, fmt.Errorf("cannot compare %d and %d", a, b)
+	}
+	return cmp.Compare(a, b), nil
+}
+
+func TestLoad(t *testing.T) {
+	got := catalog.Load([]byte(`{"version": "2026-10-19", "releases": ["3", "1", "2"]}`), parse)
+	if want := (catalog.Catalog[release]{Version: "2026-10-19", Releases: []release{1, 2, 3}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() = %v, want %v", got, want)
+	}
+}
+
+func TestKnown(t *testing.T) {
+	rs := []release{1, 2, 3, 5, 8}
+	for _, tt := range []struct {
+		v    release
+		want bool
+	}{
+		{v: 3, want: true},
+		{v: 4, want: false},
+		{v: 8, want: true},
+		{v: 9, want: false},
+	} {
+		if got := catalog.Known(rs, tt.v); got != tt.want {
+			t.Errorf("Known(%v) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	rs := []release{1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		name   string
+		v      release
+		match  func(release) bool
+		want   release
+		wantOK bool
+	}{
+		{
+			name:   "skip incomparable",
+			v:      1,
+			match:  func(release) bool { return true },
+			want:   3,
+			wantOK: true,
+		},
+		{
+			name:   "match",
+			v:      2,
+			match:  func(r release) bool { return r > 4 },
+			want:   6,
+			wantOK: true,
+		},
+		{
+			name:  "last",
+			v:     6,
+			match: func(release) bool { return true },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := catalog.Next(rs, tt.v, compare, tt.match)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Next() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPrev(t *testing.T) {
+	rs := []release{1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		name   string
+		v      release
+		match  func(release) bool
+		want   release
+		wantOK bool
+	}{
+		{
+			name:   "skip incomparable",
+			v:      6,
+			match:  func(release) bool { return true },
+			want:   4,
+			wantOK: true,
+		},
+		{
+			name:   "match",
+			v:      5,
+			match:  func(r release) bool { return r < 2 },
+			want:   1,
+			wantOK: true,
+		},
+		{
+			name:  "first",
+			v:     1,
+			match: func(release) bool { return true },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := catalog.Prev(rs, tt.v, compare, tt.match)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Prev() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
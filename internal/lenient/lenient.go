@@ -0,0 +1,124 @@
+// Package lenient implements the input normalization of parseopt.Lenient
+package lenient
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// Normalize returns ver normalized according to the last of opts, and fills its report.
+// separator, if not nil, rewrites the platform specific dot and parenthesis variants.
+func Normalize(ver string, opts []parseopt.Options, separator func(string) string) string {
+	var o parseopt.Options
+	if len(opts) > 0 {
+		o = opts[len(opts)-1]
+	}
+
+	s := ver
+	var applied []parseopt.Normalization
+	if o.Mode == parseopt.Lenient {
+		apply := func(n parseopt.Normalization, f func(string) string) {
+			if t := f(s); t != s {
+				s = t
+				if !slices.Contains(applied, n) {
+					applied = append(applied, n)
+				}
+			}
+		}
+
+		apply(parseopt.Whitespace, strings.TrimSpace)
+		apply(parseopt.Prefix, stripPrefix)
+		apply(parseopt.Trailing, cutTrailing)
+		if separator != nil {
+			apply(parseopt.Separator, separator)
+		}
+		apply(parseopt.ZeroPadding, stripZeroPadding)
+	}
+
+	if o.Report != nil {
+		*o.Report = parseopt.Report{Input: ver, Normalized: s, Applied: applied}
+	}
+	return s
+}
+
+// Remap points a *parseerr.ParseError in err, which was returned for normalized, into ver, from which Normalize derived normalized.
+// Both Input and the span are rewritten, so that Highlight marks the caller's input.
+func Remap(err error, ver, normalized string) error {
+	var perr *parseerr.ParseError
+	if ver == normalized || !errors.As(err, &perr) {
+		return err
+	}
+
+	pos := align(ver, normalized)
+	start := min(max(perr.Offset, 0), len(normalized))
+	end := min(max(start+perr.Length, start), len(normalized))
+	perr.Input = ver
+	perr.Offset = pos[start]
+	perr.Length = 0
+	if end > start {
+		perr.Length = min(pos[end-1]+1, len(ver)) - perr.Offset
+	}
+	return err
+}
+
+// align returns the offset in ver of every byte of normalized, and len(ver) as the offset of the end of normalized.
+// Normalize only removes bytes from ver or rewrites dots into parentheses that ver does not have,
+// so the bytes of normalized are found in ver in order, and a byte that is not found is mapped to the next byte of ver.
+func align(ver, normalized string) []int {
+	pos := make([]int, len(normalized)+1)
+	j := 0
+	for i := 0; i < len(normalized); i++ {
+		if k := strings.IndexByte(ver[j:], normalized[i]); k >= 0 {
+			j += k
+			pos[i] = j
+			j++
+			continue
+		}
+		pos[i] = min(j, len(ver))
+	}
+	pos[len(normalized)] = len(ver)
+	return pos
+}
+
+func stripPrefix(s string) string {
+	// search byte-wise, since strings.ToLower may change the length of an invalid UTF-8 input
+	for i := len(s) - len("version"); i >= 0; i-- {
+		if strings.EqualFold(s[i:i+len("version")], "version") {
+			s = strings.TrimLeft(s[i+len("version"):], ": \t")
+			break
+		}
+	}
+	if len(s) > 1 && (s[0] == 'v' || s[0] == 'V') && '0' <= s[1] && s[1] <= '9' {
+		s = s[1:]
+	}
+	return s
+}
+
+// cutTrailing cuts the device text following the version off s, e.g. "17.9.4a (Cupertino)" -> "17.9.4a"
+func cutTrailing(s string) string {
+	if i := strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == ',' }); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// stripZeroPadding removes leading zeros from numbers starting a dot, parenthesis or hyphen separated part
+func stripZeroPadding(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '0' && (i == 0 || strings.IndexByte(".()-", s[i-1]) >= 0) {
+			j := i
+			for j+1 < len(s) && s[j] == '0' && '0' <= s[j+1] && s[j+1] <= '9' {
+				j++
+			}
+			i = j
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,202 @@
+package lenient_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestNormalize(t *testing.T) {
+	type args struct {
+		ver       string
+		mode      parseopt.Mode
+		separator func(string) string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       string
+		wantReport parseopt.Report
+	}{
+		{
+			name: "strict",
+			args: args{ver: " Version 17.09.04a", mode: parseopt.Strict},
+			want: " Version 17.09.04a",
+			wantReport: parseopt.Report{
+				Input:      " Version 17.09.04a",
+				Normalized: " Version 17.09.04a",
+			},
+		},
+		{
+			name: "lenient Version 17.09.04a",
+			args: args{ver: " Version 17.09.04a", mode: parseopt.Lenient},
+			want: "17.9.4a",
+			wantReport: parseopt.Report{
+				Input:      " Version 17.09.04a",
+				Normalized: "17.9.4a",
+				Applied:    []parseopt.Normalization{parseopt.Whitespace, parseopt.Prefix, parseopt.ZeroPadding},
+			},
+		},
+		{
+			name: "lenient v9.18(3)56",
+			args: args{ver: "v9.18(3)56", mode: parseopt.Lenient},
+			want: "9.18(3)56",
+			wantReport: parseopt.Report{
+				Input:      "v9.18(3)56",
+				Normalized: "9.18(3)56",
+				Applied:    []parseopt.Normalization{parseopt.Prefix},
+			},
+		},
+		{
+			name: "lenient 7.3(0)DX(1)",
+			args: args{ver: "7.3(0)DX(1)", mode: parseopt.Lenient},
+			want: "7.3(0)DX(1)",
+			wantReport: parseopt.Report{
+				Input:      "7.3(0)DX(1)",
+				Normalized: "7.3(0)DX(1)",
+			},
+		},
+		{
+			name: "lenient 9.3.08 with separator",
+			args: args{
+				ver:       "9.3.08",
+				mode:      parseopt.Lenient,
+				separator: func(s string) string { return "9.3(08)" },
+			},
+			want: "9.3(8)",
+			wantReport: parseopt.Report{
+				Input:      "9.3.08",
+				Normalized: "9.3(8)",
+				Applied:    []parseopt.Normalization{parseopt.Separator, parseopt.ZeroPadding},
+			},
+		},
+		{
+			name: "lenient IOS show version banner",
+			args: args{ver: "Cisco IOS Software, C3750E Software (C3750E-UNIVERSALK9-M), Version 15.0(2)SE11, RELEASE SOFTWARE (fc3)", mode: parseopt.Lenient},
+			want: "15.0(2)SE11",
+			wantReport: parseopt.Report{
+				Input:      "Cisco IOS Software, C3750E Software (C3750E-UNIVERSALK9-M), Version 15.0(2)SE11, RELEASE SOFTWARE (fc3)",
+				Normalized: "15.0(2)SE11",
+				Applied:    []parseopt.Normalization{parseopt.Prefix, parseopt.Trailing},
+			},
+		},
+		{
+			name: "lenient 17.9.4a (Cupertino)",
+			args: args{ver: " 17.9.4a (Cupertino)", mode: parseopt.Lenient},
+			want: "17.9.4a",
+			wantReport: parseopt.Report{
+				Input:      " 17.9.4a (Cupertino)",
+				Normalized: "17.9.4a",
+				Applied:    []parseopt.Normalization{parseopt.Whitespace, parseopt.Trailing},
+			},
+		},
+		{
+			name: "lenient 17.9. 4a",
+			args: args{ver: "17.9. 4a", mode: parseopt.Lenient},
+			want: "17.9.",
+			wantReport: parseopt.Report{
+				Input:      "17.9. 4a",
+				Normalized: "17.9.",
+				Applied:    []parseopt.Normalization{parseopt.Trailing},
+			},
+		},
+		{
+			name: "lenient invalid UTF-8 before Version",
+			args: args{ver: "\xb4Version 9.18", mode: parseopt.Lenient},
+			want: "9.18",
+			wantReport: parseopt.Report{
+				Input:      "\xb4Version 9.18",
+				Normalized: "9.18",
+				Applied:    []parseopt.Normalization{parseopt.Prefix},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var report parseopt.Report
+			got := lenient.Normalize(tt.args.ver, []parseopt.Options{{Mode: tt.args.mode, Report: &report}}, tt.args.separator)
+			if got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(report, tt.wantReport) {
+				t.Errorf("Normalize() report = %v, want %v", report, tt.wantReport)
+			}
+		})
+	}
+}
+
+func TestRemap(t *testing.T) {
+	type args struct {
+		ver        string
+		normalized string
+		err        *parseerr.ParseError
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantInput  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name: "Version 17.x9.4 minor",
+			args: args{
+				ver:        " Version 17.x9.4 ",
+				normalized: "17.x9.4",
+				err:        &parseerr.ParseError{Input: "17.x9.4", Field: parseerr.FieldMinor, Offset: 3, Length: 2},
+			},
+			wantInput:  " Version 17.x9.4 ",
+			wantOffset: 12,
+			wantLength: 2,
+		},
+		{
+			name: "17.09.0x4a maintenance",
+			args: args{
+				ver:        "17.09.0x4a",
+				normalized: "17.9.x4a",
+				err:        &parseerr.ParseError{Input: "17.9.x4a", Field: parseerr.FieldMaintenance, Offset: 5, Length: 3},
+			},
+			wantInput:  "17.09.0x4a",
+			wantOffset: 7,
+			wantLength: 3,
+		},
+		{
+			name: "9.x.8 separator",
+			args: args{
+				ver:        "9.x.8",
+				normalized: "9.x(8)",
+				err:        &parseerr.ParseError{Input: "9.x(8)", Field: parseerr.FieldMinor, Offset: 2, Length: 1},
+			},
+			wantInput:  "9.x.8",
+			wantOffset: 2,
+			wantLength: 1,
+		},
+		{
+			name: "v9.18 format",
+			args: args{
+				ver:        "v9.18",
+				normalized: "9.18",
+				err:        &parseerr.ParseError{Input: "9.18", Field: parseerr.FieldFormat, Length: 4},
+			},
+			wantInput:  "v9.18",
+			wantOffset: 1,
+			wantLength: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := lenient.Remap(tt.args.err, tt.args.ver, tt.args.normalized)
+			var perr *parseerr.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Remap() = %v, want *ParseError", err)
+			}
+			if perr.Input != tt.wantInput || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("Remap() = {Input: %q, Offset: %d, Length: %d}, want {Input: %q, Offset: %d, Length: %d}", perr.Input, perr.Offset, perr.Length, tt.wantInput, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package natural provides a numeric-aware comparison for alphanumeric version parts
+package natural
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Compare returns an integer comparing two alphanumeric version parts.
+// The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
+//
+// Both strings are split into runs of digits and runs of non-digits, which are compared in turn:
+//   - digit runs are compared numerically, ignoring leading zeros ("9" < "10", "04" == "4")
+//   - non-digit runs are compared lexically ("4a" < "4b")
+//   - a digit run sorts before a non-digit run
+//   - when one string runs out of segments first, it is the smaller ("4" < "4a" < "5")
+func Compare(a, b string) int {
+	for a != "" && b != "" {
+		var sa, sb string
+		sa, a = next(a)
+		sb, b = next(b)
+
+		da, db := isDigit(sa[0]), isDigit(sb[0])
+		switch {
+		case da && db:
+			if r := compareNumber(sa, sb); r != 0 {
+				return r
+			}
+		case da:
+			return -1
+		case db:
+			return +1
+		default:
+			if r := cmp.Compare(sa, sb); r != 0 {
+				return r
+			}
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+func next(s string) (string, string) {
+	d := isDigit(s[0])
+	i := 1
+	for ; i < len(s); i++ {
+		if isDigit(s[i]) != d {
+			break
+		}
+	}
+	return s[:i], s[i:]
+}
+
+func compareNumber(a, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	return cmp.Or(
+		cmp.Compare(len(a), len(b)),
+		cmp.Compare(a, b),
+	)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Canonical returns s without leading zeros in its digit runs, e.g. "4a" for "04a".
+// Compare(s, Canonical(s)) is always 0.
+func Canonical(s string) string {
+	var sb strings.Builder
+	for s != "" {
+		var seg string
+		seg, s = next(s)
+		if isDigit(seg[0]) {
+			if seg = strings.TrimLeft(seg, "0"); seg == "" {
+				seg = "0"
+			}
+		}
+		sb.WriteString(seg)
+	}
+	return sb.String()
+}
+
+// Base returns s without its trailing lowercase rebuild letters, e.g. "4" for "4a"
+func Base(s string) string {
+	return strings.TrimRightFunc(s, func(r rune) bool { return 'a' <= r && r <= 'z' })
+}
+
+// CompareRebuild is Compare for parts that end in rebuild letters, where the letters are ordered by their count first, as the rebuild number counts them.
+// e.g. "3" < "3a" < "3z" < "3aa" < "4"
+func CompareRebuild(a, b string) int {
+	ab, bb := Base(a), Base(b)
+	al, bl := a[len(ab):], b[len(bb):]
+	return cmp.Or(
+		Compare(ab, bb),
+		cmp.Compare(len(al), len(bl)),
+		cmp.Compare(al, bl),
+	)
+}
@@ -0,0 +1,210 @@
+package natural_test
+
+import (
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+)
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "empty",
+			args: args{a: "", b: ""},
+			want: 0,
+		},
+		{
+			name: "\"\" < 1",
+			args: args{a: "", b: "1"},
+			want: -1,
+		},
+		{
+			name: "9 < 10",
+			args: args{a: "9", b: "10"},
+			want: -1,
+		},
+		{
+			name: "04 = 4",
+			args: args{a: "04", b: "4"},
+			want: 0,
+		},
+		{
+			name: "04a = 4a",
+			args: args{a: "04a", b: "4a"},
+			want: 0,
+		},
+		{
+			name: "4 < 4a",
+			args: args{a: "4", b: "4a"},
+			want: -1,
+		},
+		{
+			name: "4a < 4b",
+			args: args{a: "4a", b: "4b"},
+			want: -1,
+		},
+		{
+			name: "4b < 5",
+			args: args{a: "4b", b: "5"},
+			want: -1,
+		},
+		{
+			name: "4a < 10",
+			args: args{a: "4a", b: "10"},
+			want: -1,
+		},
+		{
+			name: "1.10a > 1.9b",
+			args: args{a: "1.10a", b: "1.9b"},
+			want: +1,
+		},
+		{
+			name: "3 < a",
+			args: args{a: "3", b: "a"},
+			want: -1,
+		},
+		{
+			name: "18446744073709551616 > 18446744073709551615",
+			args: args{a: "18446744073709551616", b: "18446744073709551615"},
+			want: +1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := natural.Compare(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "empty",
+			args: args{s: ""},
+			want: "",
+		},
+		{
+			name: "04a",
+			args: args{s: "04a"},
+			want: "4a",
+		},
+		{
+			name: "00",
+			args: args{s: "00"},
+			want: "0",
+		},
+		{
+			name: "1.01a",
+			args: args{s: "1.01a"},
+			want: "1.1a",
+		},
+		{
+			name: "prd07",
+			args: args{s: "prd07"},
+			want: "prd7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := natural.Canonical(tt.args.s); got != tt.want {
+				t.Errorf("Canonical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBase(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "4",
+			args: args{s: "4"},
+			want: "4",
+		},
+		{
+			name: "4a",
+			args: args{s: "4a"},
+			want: "4",
+		},
+		{
+			name: "1.1a",
+			args: args{s: "1.1a"},
+			want: "1.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := natural.Base(tt.args.s); got != tt.want {
+				t.Errorf("Base() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareRebuild(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "3 < 3a",
+			args: args{a: "3", b: "3a"},
+			want: -1,
+		},
+		{
+			name: "3z < 3aa",
+			args: args{a: "3z", b: "3aa"},
+			want: -1,
+		},
+		{
+			name: "3aa < 3ab",
+			args: args{a: "3aa", b: "3ab"},
+			want: -1,
+		},
+		{
+			name: "3zz < 4",
+			args: args{a: "3zz", b: "4"},
+			want: -1,
+		},
+		{
+			name: "03a = 3a",
+			args: args{a: "03a", b: "3a"},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := natural.CompareRebuild(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("CompareRebuild() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Package parseerr provides the error type returned by every NewVersion
+package parseerr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	// FieldFormat is the Field of a ParseError whose input does not have the platform format at all
+	FieldFormat        = "format"
+	FieldMajor         = "major"
+	FieldMinor         = "minor"
+	FieldMaintenance   = "maintenance"
+	FieldVulnerability = "vulnerability"
+	FieldBuild         = "build"
+	FieldRelease       = "release"
+	FieldFeature       = "feature"
+	FieldPlatformMinor = "platform minor"
+)
+
+// ParseError represents an error in parsing a version string.
+// Input[Offset:Offset+Length] is the span that failed to parse.
+type ParseError struct {
+	Platform string
+	Input    string
+	Field    string
+	Offset   int
+	Length   int
+	Expected string
+	Err      error
+}
+
+// Error returns the error message
+func (e *ParseError) Error() string {
+	if e.Field == FieldFormat {
+		return fmt.Sprintf("unexpected %s version format. expected: %q, actual: %q", e.Platform, e.Expected, e.Input)
+	}
+
+	s := fmt.Sprintf("parse %s %s version. expected: %q, actual: %q", e.Platform, e.Field, e.Expected, e.Span())
+	if e.Err != nil {
+		s = fmt.Sprintf("%s, err: %v", s, e.Err)
+	}
+	return s
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Span returns the part of the input that failed to parse
+func (e *ParseError) Span() string {
+	start := min(max(e.Offset, 0), len(e.Input))
+	end := min(max(start+e.Length, start), len(e.Input))
+	return e.Input[start:end]
+}
+
+// Highlight returns the input followed by a line marking the span that failed to parse, e.g.
+//
+//	15.x(1)M1
+//	   ^
+func (e *ParseError) Highlight() string {
+	start := min(max(e.Offset, 0), len(e.Input))
+	return fmt.Sprintf("%s\n%s%s", e.Input, strings.Repeat(" ", start), strings.Repeat("^", max(len(e.Span()), 1)))
+}
+
+// Offset returns the offset of ss[i] in the string that was split into ss by a one byte separator
+func Offset(ss []string, i int) int {
+	n := 0
+	for _, s := range ss[:i] {
+		n += len(s) + 1
+	}
+	return n
+}
@@ -0,0 +1,77 @@
+package parseerr_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+)
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *parseerr.ParseError
+		want string
+	}{
+		{
+			name: "format",
+			err:  &parseerr.ParseError{Platform: "IOS XR", Input: "7.9", Field: parseerr.FieldFormat, Length: 3, Expected: "<major|year>.<minor|quarter>.<release>"},
+			want: `unexpected IOS XR version format. expected: "<major|year>.<minor|quarter>.<release>", actual: "7.9"`,
+		},
+		{
+			name: "minor",
+			err:  &parseerr.ParseError{Platform: "IOS XR", Input: "7.x.2", Field: "minor", Offset: 2, Length: 1, Expected: "<number>", Err: strconv.ErrSyntax},
+			want: `parse IOS XR minor version. expected: "<number>", actual: "x", err: invalid syntax`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("ParseError.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError_Unwrap(t *testing.T) {
+	err := &parseerr.ParseError{Platform: "IOS XR", Input: "7.x.2", Field: "minor", Offset: 2, Length: 1, Expected: "<number>", Err: strconv.ErrSyntax}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("errors.Is(%v, strconv.ErrSyntax) = false, want true", err)
+	}
+}
+
+func TestParseError_Highlight(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *parseerr.ParseError
+		want string
+	}{
+		{
+			name: "minor",
+			err:  &parseerr.ParseError{Input: "15.xy(1)M1", Field: "minor", Offset: 3, Length: 2},
+			want: "15.xy(1)M1\n   ^^",
+		},
+		{
+			name: "out of range",
+			err:  &parseerr.ParseError{Input: "15.0", Field: "feature", Offset: 10, Length: 2},
+			want: "15.0\n    ^",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Highlight(); got != tt.want {
+				t.Errorf("ParseError.Highlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffset(t *testing.T) {
+	ss := []string{"17", "09", "04a"}
+	for i, want := range []int{0, 3, 6} {
+		if got := parseerr.Offset(ss, i); got != want {
+			t.Errorf("Offset(%q, %d) = %v, want %v", ss, i, got, want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Package sortkey encodes version parts into printable ASCII strings whose lexical order matches their comparison
+package sortkey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	naturalEnd   = '0'
+	naturalDigit = '1'
+	naturalText  = '2'
+	textEnd      = '!'
+	escapeLow    = '"'
+	escapeHigh   = '~'
+)
+
+// Int returns the key of an integer, ordered as cmp.Compare
+func Int(n int) string {
+	if n >= 0 {
+		d := strconv.Itoa(n)
+		return string(rune('0'+len(d))) + d
+	}
+
+	d := strconv.Itoa(n)[1:]
+	var sb strings.Builder
+	sb.WriteByte('-')
+	sb.WriteByte(byte(':' + 20 - len(d)))
+	for i := 0; i < len(d); i++ {
+		sb.WriteByte('9' - d[i] + '0')
+	}
+	return sb.String()
+}
+
+// Natural returns the key of an alphanumeric version part, ordered as natural.Compare
+func Natural(s string) string {
+	var sb strings.Builder
+	for s != "" {
+		i := 1
+		digit := isDigit(s[0])
+		for ; i < len(s) && isDigit(s[i]) == digit; i++ {
+		}
+		seg := s[:i]
+		s = s[i:]
+
+		if digit {
+			seg = strings.TrimLeft(seg, "0")
+			sb.WriteByte(naturalDigit)
+			sb.WriteString(Int(len(seg)))
+			sb.WriteString(seg)
+			continue
+		}
+		sb.WriteByte(naturalText)
+		sb.WriteString(Text(seg))
+	}
+	sb.WriteByte(naturalEnd)
+	return sb.String()
+}
+
+// Rebuild returns the key of a part that ends in rebuild letters, ordered as natural.CompareRebuild
+func Rebuild(s string) string {
+	base := strings.TrimRightFunc(s, func(r rune) bool { return 'a' <= r && r <= 'z' })
+	letters := s[len(base):]
+	return Natural(base) + Int(len(letters)) + Text(letters)
+}
+
+// Text returns the key of a string, ordered as cmp.Compare.
+// Bytes that would sort at or below textEnd, or are not printable, are escaped as two hex digits after escapeLow or escapeHigh.
+func Text(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c <= escapeLow:
+			sb.WriteByte(escapeLow)
+			sb.WriteString(fmt.Sprintf("%02X", c))
+		case c >= escapeHigh:
+			sb.WriteByte(escapeHigh)
+			sb.WriteString(fmt.Sprintf("%02X", c))
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte(textEnd)
+	return sb.String()
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,53 @@
+package sortkey_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/sortkey"
+)
+
+func TestInt(t *testing.T) {
+	ns := []int{-1234, -999, -10, -9, -1, 0, 1, 9, 10, 99, 100, 12345678}
+	for _, a := range ns {
+		for _, b := range ns {
+			if got, want := cmp.Compare(sortkey.Int(a), sortkey.Int(b)), cmp.Compare(a, b); got != want {
+				t.Errorf("cmp.Compare(Int(%d), Int(%d)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestNatural(t *testing.T) {
+	ss := []string{"", "0", "1", "1a", "1b", "1.1a", "1.9b", "1.10", "04", "4", "4a", "4aa", "4b", "9", "10", "10a", "a", "ab", "b", "prd7", "prd10", "ES3", "0A", "0A ", "A\x00", "A!", "A~", "A\xff", "12345678901234567890", "123456789012345678901"}
+	for _, a := range ss {
+		for _, b := range ss {
+			if got, want := cmp.Compare(sortkey.Natural(a), sortkey.Natural(b)), natural.Compare(a, b); got != want {
+				t.Errorf("cmp.Compare(Natural(%q), Natural(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	ss := []string{"", "0", "3", "03", "3a", "3b", "3z", "3aa", "3ab", "3zz", "4", "10", "1.1a", "1.9b", "1.10", "a", "A"}
+	for _, a := range ss {
+		for _, b := range ss {
+			if got, want := cmp.Compare(sortkey.Rebuild(a), sortkey.Rebuild(b)), natural.CompareRebuild(a, b); got != want {
+				t.Errorf("cmp.Compare(Rebuild(%q), Rebuild(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	ss := []string{"", "E", "M", "S", "SE", "SG", "SY", "T", " ", "S ", "S!", "S\"", "S#", "S}", "S~", "S\x7f", "S\xff", "\x00"}
+	for _, a := range ss {
+		for _, b := range ss {
+			if got, want := cmp.Compare(sortkey.Text(a), sortkey.Text(b)), cmp.Compare(a, b); got != want {
+				t.Errorf("cmp.Compare(Text(%q), Text(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
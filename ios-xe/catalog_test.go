@@ -0,0 +1,246 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestVersion_Next(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "17.9.4",
+			args:   args{ver: "17.9.4"},
+			want:   "17.9.4a",
+			wantOK: true,
+		},
+		{
+			name:   "17.9.4a",
+			args:   args{ver: "17.9.4a"},
+			want:   "17.9.5",
+			wantOK: true,
+		},
+		{
+			name:   "3.16.1S",
+			args:   args{ver: "3.16.1S"},
+			want:   "3.16.1aS",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Next()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Next() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_Prev(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "17.9.4",
+			args:   args{ver: "17.9.4"},
+			want:   "17.9.3a",
+			wantOK: true,
+		},
+		{
+			name:   "17.9.4a",
+			args:   args{ver: "17.9.4a"},
+			want:   "17.9.4",
+			wantOK: true,
+		},
+		{
+			name:   "3.16.1S",
+			args:   args{ver: "3.16.1S"},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Prev()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.Prev() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMaintenance(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "17.9.4",
+			args:   args{ver: "17.9.4"},
+			want:   "17.9.5",
+			wantOK: true,
+		},
+		{
+			name:   "17.9.4a",
+			args:   args{ver: "17.9.4a"},
+			want:   "17.9.5",
+			wantOK: true,
+		},
+		{
+			name:   "3.16.1S",
+			args:   args{ver: "3.16.1S"},
+			want:   "3.16.2S",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMaintenance()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMaintenance() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_NextMinor(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "17.9.4",
+			args:   args{ver: "17.9.4"},
+			want:   "17.10.1",
+			wantOK: true,
+		},
+		{
+			name:   "17.9.4a",
+			args:   args{ver: "17.9.4a"},
+			want:   "17.10.1",
+			wantOK: true,
+		},
+		{
+			name:   "3.16.1S",
+			args:   args{ver: "3.16.1S"},
+			want:   "16.3.1",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.NextMinor()
+			if ok != tt.wantOK || ok && got.String() != tt.want {
+				t.Errorf("Version.NextMinor() = (%v, %v), want (%v, %v)", got.String(), ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestKnown(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "17.9.4a",
+			args: args{ver: "17.9.4a"},
+			want: true,
+		},
+		{
+			name: "17.09.04a",
+			args: args{ver: "17.09.04a"},
+			want: true,
+		},
+		{
+			name: "Gibraltar-16.12.3a",
+			args: args{ver: "Gibraltar-16.12.3a"},
+			want: true,
+		},
+		{
+			name: "17.9.4b",
+			args: args{ver: "17.9.4b"},
+			want: false,
+		},
+		{
+			name: "3.16.8S",
+			args: args{ver: "3.16.8S"},
+			want: true,
+		},
+		{
+			name: "3.16.8E",
+			args: args{ver: "3.16.8E"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := version.Known(v); got != tt.want {
+				t.Errorf("Known() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleases(t *testing.T) {
+	rs := version.Releases()
+	if len(rs) == 0 {
+		t.Fatalf("Releases() is empty")
+	}
+	for i := 1; i < len(rs); i++ {
+		if rs[i-1].SortKey() >= rs[i].SortKey() {
+			t.Errorf("Releases() is not in ascending order: %v >= %v", rs[i-1], rs[i])
+		}
+	}
+	if version.CatalogVersion() == "" {
+		t.Errorf("CatalogVersion() is empty")
+	}
+}
@@ -0,0 +1,63 @@
+package version
+
+import (
+	"cmp"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+//go:embed dates.json
+var datesJSON []byte
+
+type datesData struct {
+	Version string            `json:"version"`
+	Dates   map[string]string `json:"dates"`
+}
+
+var releaseDates = sync.OnceValue(func() map[Key]time.Time {
+	var d datesData
+	if err := json.Unmarshal(datesJSON, &d); err != nil {
+		panic(fmt.Sprintf("unmarshal dates.json. err: %v", err))
+	}
+
+	m := make(map[Key]time.Time, len(d.Dates))
+	for s, date := range d.Dates {
+		v, err := NewVersion(s)
+		if err != nil {
+			panic(fmt.Sprintf("parse release %q in dates.json. err: %v", s, err))
+		}
+		t, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			panic(fmt.Sprintf("parse release date of %q in dates.json. err: %v", s, err))
+		}
+		m[v.Key()] = t
+	}
+	return m
+})
+
+var ErrUnknownReleaseDate = fmt.Errorf("unknown release date")
+
+// ReleaseDate returns the date the release was first shipped, from the embedded dataset
+func (v Version) ReleaseDate() (time.Time, bool) {
+	t, ok := releaseDates()[v.Key()]
+	return t, ok
+}
+
+// CompareChronological returns an integer comparing two version by their release dates.
+// The result will be 0 if v1==v2, -1 if v1 was released before v2, and +1 if v1 was released after v2.
+// Unlike Compare, it orders any two releases, e.g. 3.16.10S after 16.9.1, and releases shipped on the same day are ordered by SortKey.
+// ErrUnknownReleaseDate is returned if either release is not in the dataset.
+func (v1 Version) CompareChronological(v2 Version) (int, error) {
+	t1, ok := v1.ReleaseDate()
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownReleaseDate, v1)
+	}
+	t2, ok := v2.ReleaseDate()
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownReleaseDate, v2)
+	}
+	return cmp.Or(t1.Compare(t2), cmp.Compare(v1.SortKey(), v2.SortKey())), nil
+}
@@ -0,0 +1,149 @@
+package version_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestVersion_ReleaseDate(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "17.9.4a",
+			args:   args{ver: "17.9.4a"},
+			want:   time.Date(2023, time.October, 27, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "17.09.04a",
+			args:   args{ver: "17.09.04a"},
+			want:   time.Date(2023, time.October, 27, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "3.16.10S",
+			args:   args{ver: "3.16.10S"},
+			want:   time.Date(2019, time.July, 26, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "17.99.1",
+			args: args{ver: "17.99.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.ReleaseDate()
+			if ok != tt.wantOk {
+				t.Errorf("Version.ReleaseDate() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Version.ReleaseDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_CompareChronological(t *testing.T) {
+	type args struct {
+		v1 string
+		v2 string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr error
+	}{
+		{
+			name: "3.16.10S > 16.9.1",
+			args: args{v1: "3.16.10S", v2: "16.9.1"},
+			want: +1,
+		},
+		{
+			name: "3.4.8SG < 3.16.5S",
+			args: args{v1: "3.4.8SG", v2: "3.16.5S"},
+			want: -1,
+		},
+		{
+			name: "17.12.2 < 17.13.1",
+			args: args{v1: "17.12.2", v2: "17.13.1"},
+			want: -1,
+		},
+		{
+			name: "16.9.5 > 17.1.1",
+			args: args{v1: "16.9.5", v2: "17.1.1"},
+			want: +1,
+		},
+		{
+			name: "16.10.1 vs 16.3.8 on the same day",
+			args: args{v1: "16.10.1", v2: "16.3.8"},
+			want: +1,
+		},
+		{
+			name: "17.9.4a = 17.09.04a",
+			args: args{v1: "17.9.4a", v2: "17.09.04a"},
+			want: 0,
+		},
+		{
+			name:    "17.99.1 is unknown",
+			args:    args{v1: "17.99.1", v2: "17.9.4a"},
+			wantErr: version.ErrUnknownReleaseDate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+
+			got, err := v1.CompareChronological(v2)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Version.CompareChronological() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Version.CompareChronological() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr == nil {
+				if rgot, _ := v2.CompareChronological(v1); rgot != -tt.want {
+					t.Errorf("reversed Version.CompareChronological() = %v, want %v", rgot, -tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestReleaseDate_Catalog(t *testing.T) {
+	rs := version.Releases()
+	for i, r := range rs {
+		if _, ok := r.ReleaseDate(); !ok {
+			t.Errorf("Version.ReleaseDate() of %s in the release catalog is unknown", r)
+		}
+		if i == 0 || rs[i-1].Train() != r.Train() {
+			continue
+		}
+		if got, _ := rs[i-1].CompareChronological(r); got > 0 {
+			t.Errorf("%s is released after %s on the same train", rs[i-1], r)
+		}
+	}
+}
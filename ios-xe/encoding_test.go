@@ -0,0 +1,204 @@
+package version_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_RoundTrip(t *testing.T) {
+	for _, ver := range []string{
+		"17.9.4a",
+		"Everest-16.5.1",
+		"3.16.1aS",
+		"3.4.1SG",
+		"17.09.04a",
+	} {
+		t.Run(ver, func(t *testing.T) {
+			v, err := version.NewVersion(ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+
+			got, err := version.NewVersion(v.String())
+			if err != nil {
+				t.Fatalf("NewVersion(String()) error = %v", err)
+			}
+			if !reflect.DeepEqual(got, v) {
+				t.Errorf("NewVersion(String()) = %v, want %v", got, v)
+			}
+
+			want, err := version.NewVersion(v.Canonical())
+			if err != nil {
+				t.Fatalf("NewVersion(Canonical()) error = %v", err)
+			}
+
+			text, err := v.MarshalText()
+			if err != nil {
+				t.Fatalf("Version.MarshalText() error = %v", err)
+			}
+			if string(text) != v.Canonical() {
+				t.Errorf("Version.MarshalText() = %s, want %s", text, v.Canonical())
+			}
+			var fromText version.Version
+			if err := fromText.UnmarshalText(text); err != nil {
+				t.Fatalf("Version.UnmarshalText() error = %v", err)
+			}
+			if !reflect.DeepEqual(fromText, want) {
+				t.Errorf("Version.UnmarshalText() = %v, want %v", fromText, want)
+			}
+
+			bs, err := json.Marshal(v)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var fromJSON version.Version
+			if err := json.Unmarshal(bs, &fromJSON); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(fromJSON, want) {
+				t.Errorf("json.Unmarshal() = %v, want %v", fromJSON, want)
+			}
+
+			bs, err = yaml.Marshal(v)
+			if err != nil {
+				t.Fatalf("yaml.Marshal() error = %v", err)
+			}
+			var fromYAML version.Version
+			if err := yaml.Unmarshal(bs, &fromYAML); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(fromYAML, want) {
+				t.Errorf("yaml.Unmarshal() = %v, want %v", fromYAML, want)
+			}
+		})
+	}
+}
+
+func TestParsed_RoundTrip(t *testing.T) {
+	for _, ver := range []string{
+		"17.09.04a",
+		"Version 17.09.04a",
+	} {
+		t.Run(ver, func(t *testing.T) {
+			// as UnmarshalText, parse strictly first and leniently if that fails
+			p, err := version.Parse(ver)
+			if err != nil {
+				if p, err = version.Parse(ver, parseopt.Options{Mode: parseopt.Lenient}); err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+			}
+
+			bs, err := json.Marshal(p)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if want, _ := json.Marshal(ver); string(bs) != string(want) {
+				t.Errorf("json.Marshal() = %s, want %s", bs, want)
+			}
+			var fromJSON version.Parsed
+			if err := json.Unmarshal(bs, &fromJSON); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if fromJSON != p {
+				t.Errorf("json.Unmarshal() = %v (%q), want %v (%q)", fromJSON, fromJSON.Original(), p, p.Original())
+			}
+
+			bs, err = yaml.Marshal(p)
+			if err != nil {
+				t.Fatalf("yaml.Marshal() error = %v", err)
+			}
+			var fromYAML version.Parsed
+			if err := yaml.Unmarshal(bs, &fromYAML); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+			if fromYAML != p {
+				t.Errorf("yaml.Unmarshal() = %v (%q), want %v (%q)", fromYAML, fromYAML.Original(), p, p.Original())
+			}
+
+			value, err := p.Value()
+			if err != nil {
+				t.Fatalf("Parsed.Value() error = %v", err)
+			}
+			if value != ver {
+				t.Errorf("Parsed.Value() = %v, want %v", value, ver)
+			}
+			var scanned version.Parsed
+			if err := scanned.Scan(value); err != nil {
+				t.Fatalf("Parsed.Scan() error = %v", err)
+			}
+			if scanned != p {
+				t.Errorf("Parsed.Scan() = %v (%q), want %v (%q)", scanned, scanned.Original(), p, p.Original())
+			}
+		})
+	}
+}
+
+func TestVersion_MarshalJSON(t *testing.T) {
+	v := version.Version{Release: "S", Major: 3, Minor: 16, Maintenance: "1a"}
+
+	got, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(got) != `"3.16.1aS"` {
+		t.Errorf("json.Marshal() = %s, want %s", got, `"3.16.1aS"`)
+	}
+
+	got, err = json.Marshal(version.Object(v))
+	if err != nil {
+		t.Fatalf("json.Marshal(Object) error = %v", err)
+	}
+	if string(got) != `{"Release":"S","Major":3,"Minor":16,"Maintenance":"1a"}` {
+		t.Errorf("json.Marshal(Object) = %s, want %s", got, `{"Release":"S","Major":3,"Minor":16,"Maintenance":"1a"}`)
+	}
+}
+
+func TestVersion_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: args{data: `"3.16.1aS"`},
+			want: version.Version{Release: "S", Major: 3, Minor: 16, Maintenance: "1a"},
+		},
+		{
+			name: "object",
+			args: args{data: `{"Release":"S","Major":3,"Minor":16,"Maintenance":"1a"}`},
+			want: version.Version{Release: "S", Major: 3, Minor: 16, Maintenance: "1a"},
+		},
+		{
+			name: "null",
+			args: args{data: `null`},
+		},
+		{
+			name:    "invalid",
+			args:    args{data: `"invalid"`},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got version.Version
+			if err := json.Unmarshal([]byte(tt.args.data), &got); (err != nil) != tt.wantErr {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package version
+
+import "github.com/MaineK00n/go-cisco-version/internal/natural"
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "17.9.4a", "17.09.04a" and "17.09.04a.0.6", or "Everest-16.5.1" and "16.5.1", have the same Key.
+type Key struct {
+	Release     string
+	Major       int
+	Minor       int
+	Maintenance string
+	Engineering string
+}
+
+// Key returns the key of the version, whose Maintenance and Engineering have no zero padding, and which leaves out the image build number.
+// The release of 16.x and later is only a code name, so it is left out as Compare does.
+func (v Version) Key() Key {
+	k := Key{
+		Major:       v.Major,
+		Minor:       v.Minor,
+		Maintenance: natural.Canonical(v.Maintenance),
+		Engineering: natural.Canonical(v.Engineering),
+	}
+	if v.Major == 3 {
+		k.Release = v.Release
+	}
+	return k
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Release:     k.Release,
+		Major:       k.Major,
+		Minor:       k.Minor,
+		Maintenance: k.Maintenance,
+		Engineering: k.Engineering,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
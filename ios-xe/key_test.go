@@ -0,0 +1,95 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "17.9.4a == 17.09.04a",
+			args: args{
+				v1: "17.9.4a",
+				v2: "17.09.04a",
+			},
+			want: true,
+		},
+		{
+			name: "17.9.4a == 17.09.04a.0.6",
+			args: args{
+				v1: "17.9.4a",
+				v2: "17.09.04a.0.6",
+			},
+			want: true,
+		},
+		{
+			name: "16.5.1 == Everest-16.5.1",
+			args: args{
+				v1: "16.5.1",
+				v2: "Everest-16.5.1",
+			},
+			want: true,
+		},
+		{
+			name: "3.16.1aS != 3.16.1aSY",
+			args: args{
+				v1: "3.16.1aS",
+				v2: "3.16.1aSY",
+			},
+			want: false,
+		},
+		{
+			name: "17.9.4 != 17.9.4a",
+			args: args{
+				v1: "17.9.4",
+				v2: "17.9.4a",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("17.09.04a")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "17.09.04a"}
+	if got := m[version.Version{Major: 17, Minor: 9, Maintenance: "4a"}.Key()]; got != "17.09.04a" {
+		t.Errorf("map[Key] = %q, want %q", got, "17.09.04a")
+	}
+	want := version.Version{Major: 17, Minor: 9, Maintenance: "4a"}
+	if got := v.Key().Version(); got != want {
+		t.Errorf("Key.Version() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package version
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+)
+
+// Support represents the maintenance model of an IOS-XE 16.x or later release train
+type Support string
+
+const (
+	// SupportUnknown is used when the train is not in the dataset
+	SupportUnknown Support = ""
+	// SupportStandard is Standard Maintenance, e.g. 17.10
+	SupportStandard Support = "standard"
+	// SupportExtended is Extended Maintenance, e.g. 17.9
+	SupportExtended Support = "extended"
+)
+
+// Lifecycle represents the support horizon of a release train
+type Lifecycle struct {
+	Train                     string
+	Support                   Support
+	FirstRelease              time.Time
+	EndOfMaintenance          time.Time
+	EndOfVulnerabilitySupport time.Time
+}
+
+//go:embed lifecycle.json
+var lifecycleJSON []byte
+
+var lifecycles = sync.OnceValue(func() map[string]Lifecycle {
+	var d struct {
+		Version string `json:"version"`
+		Trains  map[string]struct {
+			Support                   Support `json:"support"`
+			FirstRelease              string  `json:"first_release"`
+			EndOfMaintenance          string  `json:"end_of_maintenance"`
+			EndOfVulnerabilitySupport string  `json:"end_of_vulnerability_support"`
+		} `json:"trains"`
+	}
+	if err := json.Unmarshal(lifecycleJSON, &d); err != nil {
+		panic(fmt.Sprintf("unmarshal lifecycle.json. err: %v", err))
+	}
+
+	m := make(map[string]Lifecycle, len(d.Trains))
+	for train, t := range d.Trains {
+		l := Lifecycle{Train: train, Support: t.Support}
+		for _, f := range []struct {
+			s   string
+			dst *time.Time
+		}{
+			{s: t.FirstRelease, dst: &l.FirstRelease},
+			{s: t.EndOfMaintenance, dst: &l.EndOfMaintenance},
+			{s: t.EndOfVulnerabilitySupport, dst: &l.EndOfVulnerabilitySupport},
+		} {
+			tm, err := time.Parse(time.DateOnly, f.s)
+			if err != nil {
+				panic(fmt.Sprintf("parse date of train %q in lifecycle.json. err: %v", train, err))
+			}
+			*f.dst = tm
+		}
+		m[train] = l
+	}
+	return m
+})
+
+// Lifecycle returns the support horizon of the train of the version. 3.x trains are not in the dataset.
+func (v Version) Lifecycle() (Lifecycle, bool) {
+	l, ok := lifecycles()[v.Train()]
+	return l, ok
+}
+
+// Support returns whether the train of the version is Extended or Standard Maintenance
+func (v Version) Support() Support {
+	return lifecycles()[v.Train()].Support
+}
+
+// MaintenanceNumber returns the number of the maintenance release of the version on its train, e.g. 4 for 17.9.4a, where 1 is the first release of the train.
+// It returns false if the maintenance is not a number.
+func (v Version) MaintenanceNumber() (int, bool) {
+	n, err := strconv.Atoi(natural.Canonical(natural.Base(v.Maintenance)))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
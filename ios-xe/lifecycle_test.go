@@ -0,0 +1,120 @@
+package version_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestVersion_Lifecycle(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   version.Lifecycle
+		wantOk bool
+	}{
+		{
+			name: "17.9.4a",
+			args: args{ver: "17.9.4a"},
+			want: version.Lifecycle{
+				Train:                     "17.9",
+				Support:                   version.SupportExtended,
+				FirstRelease:              time.Date(2022, time.August, 1, 0, 0, 0, 0, time.UTC),
+				EndOfMaintenance:          time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+				EndOfVulnerabilitySupport: time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantOk: true,
+		},
+		{
+			name: "17.10.1",
+			args: args{ver: "17.10.1"},
+			want: version.Lifecycle{
+				Train:                     "17.10",
+				Support:                   version.SupportStandard,
+				FirstRelease:              time.Date(2022, time.December, 12, 0, 0, 0, 0, time.UTC),
+				EndOfMaintenance:          time.Date(2023, time.December, 12, 0, 0, 0, 0, time.UTC),
+				EndOfVulnerabilitySupport: time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+			},
+			wantOk: true,
+		},
+		{
+			name: "3.16.10S",
+			args: args{ver: "3.16.10S"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Lifecycle()
+			if ok != tt.wantOk {
+				t.Errorf("Version.Lifecycle() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Lifecycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Support(t *testing.T) {
+	tests := []struct {
+		ver  string
+		want version.Support
+	}{
+		{ver: "16.9.8", want: version.SupportExtended},
+		{ver: "16.12.10a", want: version.SupportExtended},
+		{ver: "17.3.8", want: version.SupportExtended},
+		{ver: "17.6.6a", want: version.SupportExtended},
+		{ver: "17.09.04a", want: version.SupportExtended},
+		{ver: "17.12.4", want: version.SupportExtended},
+		{ver: "16.10.1", want: version.SupportStandard},
+		{ver: "17.13.1", want: version.SupportStandard},
+		{ver: "Everest-16.5.1", want: version.SupportStandard},
+		{ver: "3.16.10S", want: version.SupportUnknown},
+		{ver: "17.99.1", want: version.SupportUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Support(); got != tt.want {
+				t.Errorf("Version.Support() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_MaintenanceNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      version.Version
+		want   int
+		wantOK bool
+	}{
+		{name: "17.9.1", v: version.Version{Major: 17, Minor: 9, Maintenance: "1"}, want: 1, wantOK: true},
+		{name: "17.9.4a", v: version.Version{Major: 17, Minor: 9, Maintenance: "4a"}, want: 4, wantOK: true},
+		{name: "17.09.04a", v: version.Version{Major: 17, Minor: 9, Maintenance: "04a"}, want: 4, wantOK: true},
+		{name: "16.12.10a", v: version.Version{Major: 16, Minor: 12, Maintenance: "10a"}, want: 10, wantOK: true},
+		{name: "3.16.1aS", v: version.Version{Release: "S", Major: 3, Minor: 16, Maintenance: "1a"}, want: 1, wantOK: true},
+		{name: "3.16.0S", v: version.Version{Release: "S", Major: 3, Minor: 16, Maintenance: "0"}, want: 0, wantOK: true},
+		{name: "17.9.x", v: version.Version{Major: 17, Minor: 9, Maintenance: "x"}, want: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.v.MaintenanceNumber()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Version.MaintenanceNumber() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package version
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// Package represents an install mode sub-package such as "cat9k-rpbase.17.09.04a.SPA.pkg"
+type Package struct {
+	Version  Version
+	Platform string
+	Name     string
+	Filename string
+	// Role is the role the package is provisioned for in packages.conf, e.g. "rp_base", or "" if unknown
+	Role string
+	// State is the state of the package in "show install summary", or InstallUnknown if the source does not tell, e.g. packages.conf
+	State InstallState
+}
+
+// NewPackage returns a parsed sub-package filename. A leading file system or directory such as "flash:" is ignored.
+func NewPackage(filename string) (Package, error) {
+	base := strings.TrimSpace(filename)
+	if _, rhs, ok := strings.Cut(base, ":"); ok {
+		base = rhs
+	}
+	base = path.Base(base)
+
+	s, ok := strings.CutSuffix(base, ".pkg")
+	if !ok {
+		return Package{}, fmt.Errorf("unexpected IOS XE package filename format. expected: %q, actual: %q", "<platform>-<name>.<major>.<minor>.<maintenance>(.SPA).pkg", filename)
+	}
+	ss := strings.Split(strings.TrimSuffix(s, ".SPA"), ".")
+	if len(ss) != 4 {
+		return Package{}, fmt.Errorf("unexpected IOS XE package filename format. expected: %q, actual: %q", "<platform>-<name>.<major>.<minor>.<maintenance>(.SPA).pkg", filename)
+	}
+	platform, name, ok := strings.Cut(ss[0], "-")
+	if !ok || platform == "" || name == "" {
+		return Package{}, fmt.Errorf("unexpected IOS XE package filename format. expected: %q, actual: %q", "<platform>-<name>.<major>.<minor>.<maintenance>(.SPA).pkg", filename)
+	}
+
+	v, err := NewVersion(strings.Join(ss[1:], "."), parseopt.Options{Mode: parseopt.Lenient})
+	if err != nil {
+		return Package{}, fmt.Errorf("parse package version. err: %w", err)
+	}
+	return Package{Version: v, Platform: platform, Name: name, Filename: base}, nil
+}
+
+// ParsePackagesConf returns the sub-packages provisioned in an install mode packages.conf, in the order they are listed
+func ParsePackagesConf(r io.Reader) ([]Package, error) {
+	var ps []Package
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "sha1sum:") {
+			continue
+		}
+
+		// <boot|iso> <fru> <slot> <bay> <role> <filename>
+		fs := strings.Fields(line)
+		if len(fs) != 6 {
+			return nil, fmt.Errorf("unexpected packages.conf line format. expected: %q, actual: %q", "<boot|iso> <fru> <slot> <bay> <role> <filename>", line)
+		}
+		p, err := NewPackage(fs[5])
+		if err != nil {
+			return nil, fmt.Errorf("parse packages.conf. err: %w", err)
+		}
+		p.Role = fs[4]
+		ps = append(ps, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read packages.conf. err: %w", err)
+	}
+	if len(ps) == 0 {
+		return nil, fmt.Errorf("no package in packages.conf")
+	}
+	return ps, nil
+}
+
+// MixedVersions returns the distinct versions of the packages in ascending order, and whether there is more than one,
+// i.e. the package set is mixed and the device may fail to boot or upgrade.
+// Inactive packages, which are only added for a later activation, are left out.
+func MixedVersions(ps []Package) ([]Version, bool) {
+	var vs []Version
+	for _, p := range ps {
+		if p.State == InstallInactive {
+			continue
+		}
+		if !slices.ContainsFunc(vs, p.Version.Equal) {
+			vs = append(vs, p.Version)
+		}
+	}
+	slices.SortFunc(vs, func(a, b Version) int { return strings.Compare(a.SortKey(), b.SortKey()) })
+	return vs, len(vs) > 1
+}
@@ -0,0 +1,209 @@
+package version_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestNewPackage(t *testing.T) {
+	type args struct {
+		filename string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Package
+		wantErr bool
+	}{
+		{
+			name: "cat9k-rpbase.17.09.04a.SPA.pkg",
+			args: args{filename: "cat9k-rpbase.17.09.04a.SPA.pkg"},
+			want: version.Package{
+				Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+				Platform: "cat9k",
+				Name:     "rpbase",
+				Filename: "cat9k-rpbase.17.09.04a.SPA.pkg",
+			},
+		},
+		{
+			name: "flash:cat9k-cc_srdriver.16.12.05b.SPA.pkg",
+			args: args{filename: "flash:cat9k-cc_srdriver.16.12.05b.SPA.pkg"},
+			want: version.Package{
+				Version:  version.Version{Major: 16, Minor: 12, Maintenance: "5b"},
+				Platform: "cat9k",
+				Name:     "cc_srdriver",
+				Filename: "cat9k-cc_srdriver.16.12.05b.SPA.pkg",
+			},
+		},
+		{
+			name:    "cat9k_iosxe.17.09.04a.SPA.bin",
+			args:    args{filename: "cat9k_iosxe.17.09.04a.SPA.bin"},
+			wantErr: true,
+		},
+		{
+			name:    "rpbase.17.09.04a.SPA.pkg",
+			args:    args{filename: "rpbase.17.09.04a.SPA.pkg"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.NewPackage(tt.args.filename)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPackage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewPackage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePackagesConf(t *testing.T) {
+	type args struct {
+		conf string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantRoles []string
+		wantMixed []string
+		wantErr   bool
+	}{
+		{
+			name: "consistent",
+			args: args{
+				conf: `#! /usr/binos/bin/packages_conf.sh
+sha1sum: 2bc8eb3d2d2e5c6c2d6e3d5bd1e3b1d0f55c3b7a
+# sha1sum above - used to verify that this file is not corrupted.
+#
+# package.conf: provisioned software file for build 2023-10-20_15.51
+#
+boot  rp 0 0   rp_boot     cat9k-rpboot.17.09.04a.SPA.pkg
+iso   rp 0 0   rp_base     cat9k-rpbase.17.09.04a.SPA.pkg
+iso   rp 0 0   rp_webui    cat9k-webui.17.09.04a.SPA.pkg
+iso   rp 0 0   guestshell  cat9k-guestshell.17.09.04a.SPA.pkg
+iso   fp 0 0   fp          cat9k-espbase.17.09.04a.SPA.pkg
+`,
+			},
+			wantRoles: []string{"rp_boot", "rp_base", "rp_webui", "guestshell", "fp"},
+			wantMixed: []string{"17.9.4a"},
+		},
+		{
+			name: "mixed",
+			args: args{
+				conf: `boot  rp 0 0   rp_boot     cat9k-rpboot.17.09.04a.SPA.pkg
+iso   rp 0 0   rp_base     cat9k-rpbase.17.09.04a.SPA.pkg
+iso   rp 0 0   guestshell  cat9k-guestshell.17.06.05.SPA.pkg
+`,
+			},
+			wantRoles: []string{"rp_boot", "rp_base", "guestshell"},
+			wantMixed: []string{"17.6.5", "17.9.4a"},
+		},
+		{
+			name: "broken line",
+			args: args{
+				conf: `iso   rp 0 0   cat9k-rpbase.17.09.04a.SPA.pkg
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty",
+			args: args{
+				conf: `# nothing
+`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.ParsePackagesConf(strings.NewReader(tt.args.conf))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePackagesConf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			var roles []string
+			for _, p := range got {
+				roles = append(roles, p.Role)
+			}
+			if !reflect.DeepEqual(roles, tt.wantRoles) {
+				t.Errorf("ParsePackagesConf() roles = %v, want %v", roles, tt.wantRoles)
+			}
+
+			vs, mixed := version.MixedVersions(got)
+			var ss []string
+			for _, v := range vs {
+				ss = append(ss, v.String())
+			}
+			if !reflect.DeepEqual(ss, tt.wantMixed) {
+				t.Errorf("MixedVersions() = %v, want %v", ss, tt.wantMixed)
+			}
+			if mixed != (len(tt.wantMixed) > 1) {
+				t.Errorf("MixedVersions() mixed = %v, want %v", mixed, len(tt.wantMixed) > 1)
+			}
+		})
+	}
+}
+
+func TestMixedVersions(t *testing.T) {
+	tests := []struct {
+		name      string
+		ps        []version.Package
+		want      []string
+		wantMixed bool
+	}{
+		{
+			name: "inactive upgrade",
+			ps: []version.Package{
+				{Version: version.Version{Major: 17, Minor: 12, Maintenance: "2"}, Name: "rpbase", State: version.InstallInactive},
+				{Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a"}, Name: "guestshell", State: version.InstallCommitted},
+				{Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a"}, Name: "rpbase", State: version.InstallCommitted},
+			},
+			want:      []string{"17.9.4a"},
+			wantMixed: false,
+		},
+		{
+			name: "uncommitted upgrade",
+			ps: []version.Package{
+				{Version: version.Version{Major: 17, Minor: 12, Maintenance: "2"}, Name: "rpbase", State: version.InstallUncommitted},
+				{Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a"}, Name: "guestshell", State: version.InstallCommitted},
+			},
+			want:      []string{"17.9.4a", "17.12.2"},
+			wantMixed: true,
+		},
+		{
+			name: "unknown state",
+			ps: []version.Package{
+				{Version: version.Version{Major: 17, Minor: 12, Maintenance: "2"}, Name: "rpbase"},
+				{Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a"}, Name: "guestshell"},
+			},
+			want:      []string{"17.9.4a", "17.12.2"},
+			wantMixed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, mixed := version.MixedVersions(tt.ps)
+			var got []string
+			for _, v := range vs {
+				got = append(got, v.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MixedVersions() = %v, want %v", got, tt.want)
+			}
+			if mixed != tt.wantMixed {
+				t.Errorf("MixedVersions() mixed = %v, want %v", mixed, tt.wantMixed)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package version
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// PatchType represents the kind of an IOS-XE patch
+type PatchType string
+
+const (
+	// PatchSMU is a Software Maintenance Upgrade fixing a single bug
+	PatchSMU PatchType = "SMU"
+	// PatchAPSP is an Auto Patch Service Pack bundling several fixes
+	PatchAPSP PatchType = "APSP"
+)
+
+// Activation represents whether a patch is activated without a reload
+type Activation string
+
+const (
+	// ActivationUnknown is used when the source does not tell, e.g. a filename
+	ActivationUnknown Activation = ""
+	// ActivationHot is activated without a reload
+	ActivationHot Activation = "hot"
+	// ActivationCold requires a reload
+	ActivationCold Activation = "cold"
+)
+
+// InstallState represents the state of a package in "show install summary"
+type InstallState string
+
+const (
+	// InstallUnknown is used when the source does not tell, e.g. a filename
+	InstallUnknown InstallState = ""
+	// InstallInactive is added but not activated
+	InstallInactive InstallState = "I"
+	// InstallUncommitted is activated but rolled back by the auto abort timer unless committed
+	InstallUncommitted InstallState = "U"
+	// InstallCommitted is activated and committed
+	InstallCommitted InstallState = "C"
+	// InstallDeactivated is deactivated but not committed
+	InstallDeactivated InstallState = "D"
+)
+
+// SMU represents an IOS-XE SMU or APSP such as "cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin"
+type SMU struct {
+	Version    Version
+	Bug        string
+	Type       PatchType
+	Activation Activation
+	Platform   string
+	State      InstallState
+}
+
+var ddtsPattern = regexp.MustCompile(`^CSC[a-z]{2}[0-9]{5}$`)
+
+// NewSMU returns a parsed SMU or APSP filename. A leading file system or directory such as "bootflash:" is ignored.
+func NewSMU(filename string) (SMU, error) {
+	base := strings.TrimSpace(filename)
+	if _, rhs, ok := strings.Cut(base, ":"); ok {
+		base = rhs
+	}
+	base = strings.TrimSuffix(path.Base(base), ".bin")
+
+	var t PatchType
+	switch {
+	case strings.HasSuffix(base, ".smu"):
+		t, base = PatchSMU, strings.TrimSuffix(base, ".smu")
+	case strings.HasSuffix(base, ".apsp"):
+		t, base = PatchAPSP, strings.TrimSuffix(base, ".apsp")
+	default:
+		return SMU{}, fmt.Errorf("unexpected IOS XE SMU filename format. expected: %q, actual: %q", "<platform>.<major>.<minor>.<maintenance>.<bug>(.SPA).(smu|apsp)(.bin)", filename)
+	}
+
+	ss := strings.Split(strings.TrimSuffix(base, ".SPA"), ".")
+	i := slices.IndexFunc(ss, ddtsPattern.MatchString)
+	if i < 4 {
+		return SMU{}, fmt.Errorf("unexpected IOS XE SMU filename format. expected: %q, actual: %q", "<platform>.<major>.<minor>.<maintenance>.<bug>(.SPA).(smu|apsp)(.bin)", filename)
+	}
+
+	v, err := NewVersion(strings.Join(ss[i-3:i], "."), parseopt.Options{Mode: parseopt.Lenient})
+	if err != nil {
+		return SMU{}, fmt.Errorf("parse SMU base version. err: %w", err)
+	}
+
+	return SMU{
+		Version:  v,
+		Bug:      ss[i],
+		Type:     t,
+		Platform: strings.Join(ss[:i-3], "."),
+	}, nil
+}
+
+// InstallSummary represents the output of "show install summary"
+type InstallSummary struct {
+	// Version is the running base image, preferring a committed image over an uncommitted one
+	Version Version
+	SMUs    []SMU
+	// Packages are the sub-packages listed by releases that show them as PKG
+	Packages []Package
+}
+
+// ParseInstallSummary returns the base image, the patches and the sub-packages listed in the output of "show install summary".
+// Only the first chassis is read if the output lists several.
+func ParseInstallSummary(r io.Reader) (InstallSummary, error) {
+	var (
+		s     InstallSummary
+		state InstallState
+		found bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fs := strings.Fields(scanner.Text())
+		if len(fs) < 3 {
+			continue
+		}
+		if found && strings.HasPrefix(fs[0], "[") {
+			break
+		}
+
+		st := InstallState(fs[1])
+		if !slices.Contains([]InstallState{InstallInactive, InstallUncommitted, InstallCommitted, InstallDeactivated}, st) {
+			continue
+		}
+
+		switch fs[0] {
+		case "IMG":
+			// e.g. "17.09.04a.0.6", where 6 is the image build number
+			v, err := NewVersion(fs[2], parseopt.Options{Mode: parseopt.Lenient})
+			if err != nil {
+				return InstallSummary{}, fmt.Errorf("parse image version. err: %w", err)
+			}
+			if !found || rank(st) > rank(state) {
+				s.Version, state = v, st
+			}
+			found = true
+		case string(PatchSMU), string(PatchAPSP):
+			p, err := NewSMU(fs[2])
+			if err != nil {
+				return InstallSummary{}, fmt.Errorf("parse patch. err: %w", err)
+			}
+			p.Type, p.State = PatchType(fs[0]), st
+			s.SMUs = append(s.SMUs, p)
+		case "PKG":
+			p, err := NewPackage(fs[2])
+			if err != nil {
+				return InstallSummary{}, fmt.Errorf("parse package. err: %w", err)
+			}
+			p.State = st
+			s.Packages = append(s.Packages, p)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return InstallSummary{}, fmt.Errorf("read install summary. err: %w", err)
+	}
+	if !found {
+		if len(s.Packages) == 0 {
+			return InstallSummary{}, fmt.Errorf("no IMG package in install summary")
+		}
+		// releases that list sub-packages have no IMG line, so the base image is the one of rpbase, or of the first sub-package,
+		// among the activated packages in the state preferred as for an IMG line
+		top := slices.MaxFunc(s.Packages, func(a, b Package) int { return cmp.Compare(rank(a.State), rank(b.State)) })
+		if rank(top.State) == 0 {
+			return InstallSummary{}, fmt.Errorf("no activated package in install summary")
+		}
+		ps := slices.DeleteFunc(slices.Clone(s.Packages), func(p Package) bool { return rank(p.State) < rank(top.State) })
+		s.Version = ps[0].Version
+		if i := slices.IndexFunc(ps, func(p Package) bool { return p.Name == "rpbase" }); i >= 0 {
+			s.Version = ps[i].Version
+		}
+	}
+	return s, nil
+}
+
+// rank orders the states of the image that is running
+func rank(s InstallState) int {
+	switch s {
+	case InstallCommitted:
+		return 2
+	case InstallUncommitted:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Fixed reports whether a device running base with smus is effectively fixed for bug, given the first fixed releases of the bug.
+// A patch fixes the bug if it is for the bug and the base, and is committed or of unknown state, e.g. parsed from a filename.
+// Otherwise the base is fixed if it is at or above the smallest fixed release on its train, or on a train later than every listed one.
+// The order of fixedIn does not matter, e.g. both a rebuild and the next maintenance release of a train may be listed.
+func Fixed(base Version, smus []SMU, bug string, fixedIn []Version) bool {
+	for _, p := range smus {
+		if strings.EqualFold(p.Bug, bug) && p.Version.Equal(base) && (p.State == InstallCommitted || p.State == InstallUnknown) {
+			return true
+		}
+	}
+
+	var (
+		first Version
+		found bool
+	)
+	later := len(fixedIn) > 0
+	for _, f := range fixedIn {
+		if f.Train() == base.Train() {
+			if c, err := f.Compare(first); !found || err == nil && c < 0 {
+				first, found = f, true
+			}
+			continue
+		}
+		if c, err := base.Compare(f); err != nil || c <= 0 {
+			later = false
+		}
+	}
+	if found {
+		c, err := base.Compare(first)
+		return err == nil && c >= 0
+	}
+	return later
+}
+
+// ParseInstallPackage returns the patch described by the output of "show install package <file>", including whether it is activated without a reload
+func ParseInstallPackage(r io.Reader) (SMU, error) {
+	var (
+		p          SMU
+		found      bool
+		activation Activation
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "name":
+			s, err := NewSMU(value)
+			if err != nil {
+				return SMU{}, fmt.Errorf("parse patch. err: %w", err)
+			}
+			p, found = s, true
+		case "smu type":
+			switch strings.ToLower(value) {
+			case "non-reload", "hot":
+				activation = ActivationHot
+			case "reload", "cold":
+				activation = ActivationCold
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return SMU{}, fmt.Errorf("read install package. err: %w", err)
+	}
+	if !found {
+		return SMU{}, fmt.Errorf("no patch name in install package")
+	}
+	p.Activation = activation
+	return p, nil
+}
@@ -0,0 +1,390 @@
+package version_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestNewSMU(t *testing.T) {
+	type args struct {
+		filename string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.SMU
+		wantErr bool
+	}{
+		{
+			name: "cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin",
+			args: args{filename: "cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin"},
+			want: version.SMU{
+				Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+				Bug:      "CSCwh87343",
+				Type:     version.PatchSMU,
+				Platform: "cat9k_iosxe",
+			},
+		},
+		{
+			name: "bootflash:isr4300-universalk9.17.03.04a.CSCvx12345.SPA.apsp.bin",
+			args: args{filename: "bootflash:isr4300-universalk9.17.03.04a.CSCvx12345.SPA.apsp.bin"},
+			want: version.SMU{
+				Version:  version.Version{Major: 17, Minor: 3, Maintenance: "4a"},
+				Bug:      "CSCvx12345",
+				Type:     version.PatchAPSP,
+				Platform: "isr4300-universalk9",
+			},
+		},
+		{
+			name:    "cat9k_iosxe.17.09.04a.SPA.bin",
+			args:    args{filename: "cat9k_iosxe.17.09.04a.SPA.bin"},
+			wantErr: true,
+		},
+		{
+			name:    "cat9k_iosxe.17.09.SPA.smu.bin",
+			args:    args{filename: "cat9k_iosxe.17.09.SPA.smu.bin"},
+			wantErr: true,
+		},
+		{
+			name:    "CSCwh87343.SPA.smu.bin",
+			args:    args{filename: "CSCwh87343.SPA.smu.bin"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.NewSMU(tt.args.filename)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSMU() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSMU() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInstallSummary(t *testing.T) {
+	type args struct {
+		output string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.InstallSummary
+		wantErr bool
+	}{
+		{
+			name: "cat9k",
+			args: args{
+				output: `[ Chassis 1/R0 ] Installed Package(s) Information:
+State (St): I - Inactive, U - Activated & Uncommitted,
+            C - Activated & Committed, D - Deactivated & Uncommitted
+--------------------------------------------------------------------------------
+Type  St   Filename/Version
+--------------------------------------------------------------------------------
+SMU   C    bootflash:cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin
+APSP  U    bootflash:cat9k_iosxe.17.09.04a.CSCwi11111.SPA.apsp.bin
+IMG   I    17.06.05.0.269
+IMG   C    17.09.04a.0.6
+
+--------------------------------------------------------------------------------
+Auto abort timer: active on install_activate, time before rollback - 01:52:13
+--------------------------------------------------------------------------------
+[ Chassis 2/R0 ] Installed Package(s) Information:
+Type  St   Filename/Version
+--------------------------------------------------------------------------------
+IMG   C    17.09.04a.0.6
+`,
+			},
+			want: version.InstallSummary{
+				Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a", Build: 6},
+				SMUs: []version.SMU{
+					{
+						Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+						Bug:      "CSCwh87343",
+						Type:     version.PatchSMU,
+						Platform: "cat9k_iosxe",
+						State:    version.InstallCommitted,
+					},
+					{
+						Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+						Bug:      "CSCwi11111",
+						Type:     version.PatchAPSP,
+						Platform: "cat9k_iosxe",
+						State:    version.InstallUncommitted,
+					},
+				},
+			},
+		},
+		{
+			name: "sub-packages",
+			args: args{
+				output: `[ R0 ] Installed Package(s) Information:
+Type  St   Filename/Version
+--------------------------------------------------------------------------------
+PKG   C    flash:cat9k-guestshell.16.12.05.SPA.pkg
+PKG   C    flash:cat9k-rpbase.16.12.05b.SPA.pkg
+`,
+			},
+			want: version.InstallSummary{
+				Version: version.Version{Major: 16, Minor: 12, Maintenance: "5b"},
+				Packages: []version.Package{
+					{
+						Version:  version.Version{Major: 16, Minor: 12, Maintenance: "5"},
+						Platform: "cat9k",
+						Name:     "guestshell",
+						Filename: "cat9k-guestshell.16.12.05.SPA.pkg",
+						State:    version.InstallCommitted,
+					},
+					{
+						Version:  version.Version{Major: 16, Minor: 12, Maintenance: "5b"},
+						Platform: "cat9k",
+						Name:     "rpbase",
+						Filename: "cat9k-rpbase.16.12.05b.SPA.pkg",
+						State:    version.InstallCommitted,
+					},
+				},
+			},
+		},
+		{
+			name: "sub-packages with inactive upgrade",
+			args: args{
+				output: `[ R0 ] Installed Package(s) Information:
+Type  St   Filename/Version
+--------------------------------------------------------------------------------
+PKG   I    flash:cat9k-rpbase.17.12.02.SPA.pkg
+PKG   C    flash:cat9k-guestshell.17.09.04a.SPA.pkg
+PKG   C    flash:cat9k-rpbase.17.09.04a.SPA.pkg
+`,
+			},
+			want: version.InstallSummary{
+				Version: version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+				Packages: []version.Package{
+					{
+						Version:  version.Version{Major: 17, Minor: 12, Maintenance: "2"},
+						Platform: "cat9k",
+						Name:     "rpbase",
+						Filename: "cat9k-rpbase.17.12.02.SPA.pkg",
+						State:    version.InstallInactive,
+					},
+					{
+						Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+						Platform: "cat9k",
+						Name:     "guestshell",
+						Filename: "cat9k-guestshell.17.09.04a.SPA.pkg",
+						State:    version.InstallCommitted,
+					},
+					{
+						Version:  version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+						Platform: "cat9k",
+						Name:     "rpbase",
+						Filename: "cat9k-rpbase.17.09.04a.SPA.pkg",
+						State:    version.InstallCommitted,
+					},
+				},
+			},
+		},
+		{
+			name: "inactive sub-packages only",
+			args: args{
+				output: `Type  St   Filename/Version
+PKG   I    flash:cat9k-rpbase.17.12.02.SPA.pkg
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no image",
+			args: args{
+				output: `Type  St   Filename/Version
+SMU   C    bootflash:cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin
+`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.ParseInstallSummary(strings.NewReader(tt.args.output))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInstallSummary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInstallSummary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixed(t *testing.T) {
+	smu, err := version.NewSMU("cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin")
+	if err != nil {
+		t.Fatalf("NewSMU() error = %v", err)
+	}
+	uncommitted := smu
+	uncommitted.State = version.InstallUncommitted
+
+	type args struct {
+		base    string
+		smus    []version.SMU
+		bug     string
+		fixedIn []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "17.9.4a with the SMU",
+			args: args{base: "17.9.4a", smus: []version.SMU{smu}, bug: "CSCwh87343", fixedIn: []string{"17.9.5"}},
+			want: true,
+		},
+		{
+			name: "17.9.4a with the uncommitted SMU",
+			args: args{base: "17.9.4a", smus: []version.SMU{uncommitted}, bug: "CSCwh87343", fixedIn: []string{"17.9.5"}},
+			want: false,
+		},
+		{
+			name: "17.9.4a with an SMU for another bug",
+			args: args{base: "17.9.4a", smus: []version.SMU{smu}, bug: "CSCwi11111", fixedIn: []string{"17.9.5"}},
+			want: false,
+		},
+		{
+			name: "17.9.4 with the SMU for 17.9.4a",
+			args: args{base: "17.9.4", smus: []version.SMU{smu}, bug: "CSCwh87343", fixedIn: []string{"17.9.5"}},
+			want: false,
+		},
+		{
+			name: "17.9.5 without SMU",
+			args: args{base: "17.9.5", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.12.2"}},
+			want: true,
+		},
+		{
+			name: "17.12.1 without SMU",
+			args: args{base: "17.12.1", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.12.2"}},
+			want: false,
+		},
+		{
+			name: "17.15.1 on a later train",
+			args: args{base: "17.15.1", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.12.2"}},
+			want: true,
+		},
+		{
+			name: "17.9.4a with the rebuild listed first",
+			args: args{base: "17.9.4a", bug: "CSCwh87343", fixedIn: []string{"17.9.4a", "17.9.5"}},
+			want: true,
+		},
+		{
+			name: "17.9.4a with the rebuild listed last",
+			args: args{base: "17.9.4a", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.9.4a"}},
+			want: true,
+		},
+		{
+			name: "17.9.4 with the rebuild listed last",
+			args: args{base: "17.9.4", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.9.4a"}},
+			want: false,
+		},
+		{
+			name: "17.10.1 between the listed trains",
+			args: args{base: "17.10.1", bug: "CSCwh87343", fixedIn: []string{"17.9.5", "17.12.2"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, err := version.NewVersion(tt.args.base)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			var fixedIn []version.Version
+			for _, s := range tt.args.fixedIn {
+				f, err := version.NewVersion(s)
+				if err != nil {
+					t.Fatalf("NewVersion() error = %v", err)
+				}
+				fixedIn = append(fixedIn, f)
+			}
+			if got := version.Fixed(base, tt.args.smus, tt.args.bug, fixedIn); got != tt.want {
+				t.Errorf("Fixed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInstallPackage(t *testing.T) {
+	type args struct {
+		output string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.SMU
+		wantErr bool
+	}{
+		{
+			name: "non-reload",
+			args: args{
+				output: `Name: cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin
+Version: 17.09.04a.0.6
+Platform: Catalyst 9300
+Package Type: SMU
+Defect ID: CSCwh87343
+Package State: Added
+Supersedes List: {}
+Smu ID: 1
+SMU Type: non-reload
+SMU Compatible with Version: 17.09.04a.0.6
+`,
+			},
+			want: version.SMU{
+				Version:    version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+				Bug:        "CSCwh87343",
+				Type:       version.PatchSMU,
+				Activation: version.ActivationHot,
+				Platform:   "cat9k_iosxe",
+			},
+		},
+		{
+			name: "reload",
+			args: args{
+				output: `Name: cat9k_iosxe.17.09.04a.CSCwh87343.SPA.smu.bin
+SMU Type: reload
+`,
+			},
+			want: version.SMU{
+				Version:    version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+				Bug:        "CSCwh87343",
+				Type:       version.PatchSMU,
+				Activation: version.ActivationCold,
+				Platform:   "cat9k_iosxe",
+			},
+		},
+		{
+			name: "no name",
+			args: args{
+				output: `SMU Type: reload
+`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.ParseInstallPackage(strings.NewReader(tt.args.output))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInstallPackage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInstallPackage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
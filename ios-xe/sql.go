@@ -0,0 +1,56 @@
+package version
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/MaineK00n/go-cisco-version/internal/sortkey"
+)
+
+// Scan implements sql.Scanner. A NULL value leaves the version unchanged.
+func (v *Version) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	default:
+		return fmt.Errorf("unexpected type to scan into Version. expected: %q, actual: %T", []string{"string", "[]byte"}, src)
+	}
+}
+
+// Value implements driver.Valuer with the canonical string
+func (v Version) Value() (driver.Value, error) {
+	return v.Canonical(), nil
+}
+
+// Scan implements sql.Scanner. A NULL value leaves the version unchanged.
+func (p *Parsed) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return p.UnmarshalText([]byte(src))
+	case []byte:
+		return p.UnmarshalText(src)
+	default:
+		return fmt.Errorf("unexpected type to scan into Parsed. expected: %q, actual: %T", []string{"string", "[]byte"}, src)
+	}
+}
+
+// Value implements driver.Valuer with the original string
+func (p Parsed) Value() (driver.Value, error) {
+	return p.Original(), nil
+}
+
+// SortKey returns a printable key whose lexical order matches Compare.
+// 3.x versions are grouped by the last train of their continuation, and the ones that Compare cannot order because of different releases are ordered by the release.
+func (v Version) SortKey() string {
+	if v.Major == 3 {
+		n, last := successors(v.Release)
+		return sortkey.Int(v.Major) + sortkey.Text(last) + sortkey.Int(v.Minor) + sortkey.Int(-n) + sortkey.Text(v.Release) + sortkey.Natural(v.Maintenance)
+	}
+	return sortkey.Int(v.Major) + sortkey.Int(v.Minor) + sortkey.Natural(v.Maintenance) + sortkey.Int(v.phase()) + sortkey.Natural(v.Engineering)
+}
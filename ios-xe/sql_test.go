@@ -0,0 +1,98 @@
+package version_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestVersion_Scan(t *testing.T) {
+	type args struct {
+		src any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: args{src: "17.9.4a"},
+			want: version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+		},
+		{
+			name: "[]byte",
+			args: args{src: []byte("17.9.4a")},
+			want: version.Version{Major: 17, Minor: 9, Maintenance: "4a"},
+		},
+		{
+			name: "nil",
+			args: args{src: nil},
+		},
+		{
+			name:    "int64",
+			args:    args{src: int64(1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got version.Version
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Version.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Value(t *testing.T) {
+	v := version.Version{Major: 17, Minor: 9, Maintenance: "04a"}
+	got, err := v.Value()
+	if err != nil {
+		t.Fatalf("Version.Value() error = %v", err)
+	}
+	if got != "17.9.4a" {
+		t.Errorf("Version.Value() = %v, want %v", got, "17.9.4a")
+	}
+}
+
+func TestVersion_SortKey(t *testing.T) {
+	var vs []version.Version
+	for _, s := range []string{
+		"3.4.1SG",
+		"3.16.1aS",
+		"3.16.2S",
+		"3.16.10S",
+		"16.5.1",
+		"16.5.1a",
+		"16.12.10",
+		"17.9.4",
+		"17.9.4a",
+		"17.9.10",
+	} {
+		v, err := version.NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+
+	for _, v1 := range vs {
+		for _, v2 := range vs {
+			want, err := v1.Compare(v2)
+			if err != nil {
+				continue
+			}
+			if got := cmp.Compare(v1.SortKey(), v2.SortKey()); got != want {
+				t.Errorf("cmp.Compare(%q.SortKey(), %q.SortKey()) = %d, want %d", v1, v2, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package version
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// TrainInfo represents an IOS-XE 3.x release train, identified by its release letters
+type TrainInfo struct {
+	Release  string
+	Hardware []string
+	// Successor is the release letters of the 3.x train that continues this one on its hardware, or "" if the train continues in 16.x
+	Successor string
+}
+
+//go:embed trains.json
+var trainsJSON []byte
+
+var trains = sync.OnceValue(func() map[string]TrainInfo {
+	var d map[string]struct {
+		Hardware  []string `json:"hardware"`
+		Successor string   `json:"successor"`
+	}
+	if err := json.Unmarshal(trainsJSON, &d); err != nil {
+		panic(fmt.Sprintf("unmarshal trains.json. err: %v", err))
+	}
+
+	m := make(map[string]TrainInfo, len(d))
+	for r, t := range d {
+		m[r] = TrainInfo{Release: r, Hardware: t.Hardware, Successor: t.Successor}
+	}
+	for r, t := range m {
+		if _, ok := m[t.Successor]; t.Successor != "" && !ok {
+			panic(fmt.Sprintf("unknown successor %q of train %q in trains.json", t.Successor, r))
+		}
+	}
+	return m
+})
+
+// LookupTrain returns the 3.x release train of the release letters, e.g. "SG"
+func LookupTrain(release string) (TrainInfo, bool) {
+	t, ok := trains()[release]
+	if !ok {
+		return TrainInfo{}, false
+	}
+	t.Hardware = slices.Clone(t.Hardware)
+	return t, true
+}
+
+// TrainInfo returns the 3.x release train of the version. It is not found for 16.x and later.
+func (v Version) TrainInfo() (TrainInfo, bool) {
+	if v.Major != 3 {
+		return TrainInfo{}, false
+	}
+	return LookupTrain(v.Release)
+}
+
+// successors returns the number of successor steps from release to the last train of its continuation, and that last train.
+// A release that is not in the catalog is the last train of its own.
+func successors(release string) (int, string) {
+	n := 0
+	for {
+		t, ok := trains()[release]
+		if !ok || t.Successor == "" || n > len(trains()) {
+			return n, release
+		}
+		release = t.Successor
+		n++
+	}
+}
+
+// continuation returns -1 if the train of r1 is continued by the train of r2, +1 if the other way around, and ok = false if neither is
+func continuation(r1, r2 string) (int, bool) {
+	n1, last1 := successors(r1)
+	n2, last2 := successors(r2)
+	if last1 != last2 || n1 == n2 {
+		return 0, false
+	}
+
+	// the train with more steps left continues into the other only if it passes through it
+	older, newer, r := r1, r2, -1
+	if n1 < n2 {
+		older, newer, r = r2, r1, +1
+	}
+	for older != newer {
+		t, ok := trains()[older]
+		if !ok || t.Successor == "" {
+			return 0, false
+		}
+		older = t.Successor
+	}
+	return r, true
+}
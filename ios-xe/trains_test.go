@@ -0,0 +1,104 @@
+package version_test
+
+import (
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+)
+
+func TestLookupTrain(t *testing.T) {
+	type args struct {
+		release string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   version.TrainInfo
+		wantOk bool
+	}{
+		{
+			name: "SG",
+			args: args{release: "SG"},
+			want: version.TrainInfo{
+				Release:   "SG",
+				Hardware:  []string{"Catalyst 4500E Supervisor 7-E", "Catalyst 4500-X"},
+				Successor: "E",
+			},
+			wantOk: true,
+		},
+		{
+			name: "SP",
+			args: args{release: "SP"},
+			want: version.TrainInfo{
+				Release:  "SP",
+				Hardware: []string{"ASR 900", "ASR 920", "NCS 4200"},
+			},
+			wantOk: true,
+		},
+		{
+			name: "XO",
+			args: args{release: "XO"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := version.LookupTrain(tt.args.release)
+			if ok != tt.wantOk {
+				t.Errorf("LookupTrain() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupTrain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_TrainInfo(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantSuccessor string
+		wantOk        bool
+	}{
+		{
+			name:          "3.3.5SE",
+			args:          args{ver: "3.3.5SE"},
+			wantSuccessor: "E",
+			wantOk:        true,
+		},
+		{
+			name:          "3.16.10S",
+			args:          args{ver: "3.16.10S"},
+			wantSuccessor: "SP",
+			wantOk:        true,
+		},
+		{
+			name:   "3.6.10E",
+			args:   args{ver: "3.6.10E"},
+			wantOk: true,
+		},
+		{
+			name: "Denali-16.3.1",
+			args: args{ver: "Denali-16.3.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.TrainInfo()
+			if ok != tt.wantOk {
+				t.Errorf("Version.TrainInfo() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if got.Successor != tt.wantSuccessor {
+				t.Errorf("Version.TrainInfo().Successor = %v, want %v", got.Successor, tt.wantSuccessor)
+			}
+		})
+	}
+}
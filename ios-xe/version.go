@@ -3,9 +3,15 @@ package version
 import (
 	"cmp"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 // Version represents an IOS-XE version
@@ -15,22 +21,121 @@ type Version struct {
 	Major       int
 	Minor       int
 	Maintenance string
+	// Engineering is the marker and number of a 16.x or later engineering build, e.g. "prd7" for 17.12.1prd7 or "ES3" for 16.12.5b.ES3
+	Engineering string `json:",omitempty"`
+	// Build is the image build number printed after the maintenance of 16.x or later, e.g. 6 for 17.09.04a.0.6, or 0 if it is not printed.
+	// GA images print it too, e.g. in "show install summary", so it does not tell a throttle build from a GA release and takes no part in ordering:
+	// 17.9.3.0.1234 is the same release as 17.9.3 for Compare, SortKey and Key.
+	Build int `json:",omitempty"`
 }
 
+const platformName = "IOS XE"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat  = parseerr.FieldFormat
+	FieldMajor   = parseerr.FieldMajor
+	FieldMinor   = parseerr.FieldMinor
+	FieldBuild   = parseerr.FieldBuild
+	FieldRelease = parseerr.FieldRelease
+)
+
 // NewVersion returns a parsed version
-func NewVersion(ver string) (Version, error) {
-	switch ss := strings.Split(ver, "."); len(ss) {
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, normalizeSeparator)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+func parse(ver string) (Version, error) {
+	base, engineering, build, err := splitBuild(ver)
+	if err != nil {
+		return Version{}, err
+	}
+
+	v, err := parseBase(ver, base)
+	if err != nil {
+		return Version{}, err
+	}
+	if v.Major == 3 {
+		if engineering != "" || build != 0 {
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>.<maintenance>(<release>)"}
+		}
+		return v, nil
+	}
+
+	// a prd marker is attached to the maintenance, e.g. "01prd7"
+	if i := strings.Index(v.Maintenance, "prd"); i > 0 {
+		if engineering != "" {
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "(<release>-)<major>.<minor>.<maintenance>(prd<number>|.ES<number>)(.0.<build>)"}
+		}
+		v.Maintenance, engineering = v.Maintenance[:i], v.Maintenance[i:]
+	}
+	v.Engineering, v.Build = engineering, build
+	return v, nil
+}
+
+// splitBuild splits the ES marker and the image build number off ver, e.g. "16.12.5b.ES3" -> "16.12.5b", "ES3" and "17.9.3.0.1234" -> "17.9.3", 1234
+func splitBuild(ver string) (string, string, int, error) {
+	switch ss := strings.Split(ver, "."); {
+	case len(ss) == 4 && esPattern.MatchString(ss[3]):
+		return strings.Join(ss[:3], "."), ss[3], 0, nil
+	case len(ss) == 5 && ss[3] == "0":
+		build, err := strconv.Atoi(ss[4])
+		if err != nil {
+			return "", "", 0, &ParseError{Platform: platformName, Input: ver, Field: FieldBuild, Offset: parseerr.Offset(ss, 4), Length: len(ss[4]), Expected: "<number>", Err: err}
+		}
+		if build <= 0 {
+			return "", "", 0, &ParseError{Platform: platformName, Input: ver, Field: FieldBuild, Offset: parseerr.Offset(ss, 4), Length: len(ss[4]), Expected: "<positive number>"}
+		}
+		return strings.Join(ss[:3], "."), "", build, nil
+	default:
+		return ver, "", 0, nil
+	}
+}
+
+var esPattern = regexp.MustCompile(`^ES[0-9]+$`)
+
+// parseBase parses base, which is ver without its build parts
+func parseBase(ver, base string) (Version, error) {
+	switch ss := strings.Split(base, "."); len(ss) {
 	case 3:
 		switch {
-		case strings.HasPrefix(ss[0], "03"), strings.HasPrefix(ss[0], "3"):
-			major, err := strconv.Atoi(ss[0])
-			if err != nil {
-				return Version{}, fmt.Errorf("parse major version. err: %w", err)
-			}
-
+		case ss[0] == "03", ss[0] == "3":
 			minor, err := strconv.Atoi(ss[1])
 			if err != nil {
-				return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+				return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: parseerr.Offset(ss, 1), Length: len(ss[1]), Expected: "<number>", Err: err}
 			}
 
 			maintenance, release := func() (string, string) {
@@ -45,7 +150,7 @@ func NewVersion(ver string) (Version, error) {
 
 			return Version{
 				Release:     release,
-				Major:       major,
+				Major:       3,
 				Minor:       minor,
 				Maintenance: maintenance,
 			}, nil
@@ -53,26 +158,33 @@ func NewVersion(ver string) (Version, error) {
 			release, major, err := func() (string, int, error) {
 				lhs, rhs, ok := strings.Cut(ss[0], "-")
 				if ok {
+					if lhs == "" {
+						return "", 0, &ParseError{Platform: platformName, Input: ver, Field: FieldRelease, Expected: "<release>"}
+					}
 					major, err := strconv.Atoi(rhs)
 					if err != nil {
-						return "", 0, fmt.Errorf("parse major version. err: %w", err)
+						return "", 0, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: len(lhs) + len("-"), Length: len(rhs), Expected: "<number>", Err: err}
 					}
 					return lhs, major, nil
 				}
 
 				major, err := strconv.Atoi(ss[0])
 				if err != nil {
-					return "", 0, fmt.Errorf("parse major version. err: %w", err)
+					return "", 0, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: parseerr.Offset(ss, 0), Length: len(ss[0]), Expected: "<number>", Err: err}
 				}
 				return "", major, nil
 			}()
 			if err != nil {
-				return Version{}, fmt.Errorf("parse release+major version. err: %w", err)
+				return Version{}, err
+			}
+			// e.g. "+3.0.A" or "X-3.0.1", which would not round-trip as 3.x
+			if major == 3 {
+				return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: len(ss[0]) - len("3"), Length: len("3"), Expected: "<major>.<minor>.<maintenance>(<release>)"}
 			}
 
 			minor, err := strconv.Atoi(ss[1])
 			if err != nil {
-				return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+				return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: parseerr.Offset(ss, 1), Length: len(ss[1]), Expected: "<number>", Err: err}
 			}
 
 			return Version{
@@ -83,29 +195,75 @@ func NewVersion(ver string) (Version, error) {
 			}, nil
 		}
 	default:
-		return Version{}, fmt.Errorf("unexpected IOS XE version format. expected: %q, actual: %q", []string{"(<release>-)<major>.<minor>.<maintenance>", "<major>.<minor>.<maintenance>(<release>)"}, ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "(<release>-)<major>.<minor>.<maintenance> or <major>.<minor>.<maintenance>(<release>)"}
 	}
 }
 
+// normalizeSeparator joins a release separated by a dot, e.g. "03.16.08.S" -> "03.16.08S"
+func normalizeSeparator(ver string) string {
+	ss := strings.Split(ver, ".")
+	if len(ss) != 4 || ss[3] == "" || strings.IndexFunc(ss[3], func(r rune) bool { return !unicode.IsUpper(r) }) >= 0 {
+		return ver
+	}
+	return fmt.Sprintf("%s.%s.%s%s", ss[0], ss[1], ss[2], ss[3])
+}
+
 var ErrCannotCompareDifferentRelease = fmt.Errorf("cannot compare versions with different release types")
 
 // Compare returns an integer comparing two version.
 // The result will be 0 if v1==v2, -1 if v1 < v2, and +1 if v1 > v2.
+//
+// Versions are ordered by generation first, so every 3.x release is smaller than every 16.x release, which is smaller than every 17.x release,
+// even though trains such as 3.18SP and 16.3 were maintained at the same time. The code name of 16.x and later, e.g. "Everest", is ignored.
+// 3.x versions of different release trains are ordered only if one train continues the other on the same hardware according to the train catalog (see TrainInfo),
+// e.g. 3.4.8SG < 3.6.0E, by the minor version first and the continuing train second, e.g. 3.18.4S < 3.18.1SP.
+// Otherwise, e.g. 3.16S and 3.4SG, ErrCannotCompareDifferentRelease is returned whichever the argument order is.
+// Use CompareChronological to order any releases by their release dates instead.
 func (v1 Version) Compare(v2 Version) (int, error) {
 	if r := cmp.Compare(v1.Major, v2.Major); r != 0 {
 		return r, nil
 	}
 
+	// both are of the same generation here
 	if v1.Major == 3 && v1.Release != v2.Release {
-		return 0, ErrCannotCompareDifferentRelease
+		r, ok := continuation(v1.Release, v2.Release)
+		if !ok {
+			return 0, ErrCannotCompareDifferentRelease
+		}
+		return cmp.Or(cmp.Compare(v1.Minor, v2.Minor), r), nil
 	}
 
 	return cmp.Or(
 		cmp.Compare(v1.Minor, v2.Minor),
-		cmp.Compare(v1.Maintenance, v2.Maintenance),
+		natural.Compare(v1.Maintenance, v2.Maintenance),
+		cmp.Compare(v1.phase(), v2.phase()),
+		natural.Compare(v1.Engineering, v2.Engineering),
 	), nil
 }
 
+// phase orders the builds of a maintenance release: prd builds precede the GA release,
+// and ES builds, which are made on top of it, follow it
+func (v Version) phase() int {
+	switch {
+	case strings.HasPrefix(v.Engineering, "prd"):
+		return -1
+	case v.Engineering == "":
+		return 0
+	default:
+		return +1
+	}
+}
+
+// Prerelease reports whether the version is a prd build made before its GA release, e.g. 17.12.1prd7
+func (v Version) Prerelease() bool {
+	return v.phase() < 0
+}
+
+// GA reports whether the version is a GA release rather than an engineering build. The image build number is ignored, e.g. 17.09.04a.0.6 is GA.
+func (v Version) GA() bool {
+	return v.phase() == 0
+}
+
 // String returns the full version string
 func (v Version) String() string {
 	switch v.Major {
@@ -117,6 +275,30 @@ func (v Version) String() string {
 			sb.WriteString(fmt.Sprintf("%s-", v.Release))
 		}
 		sb.WriteString(fmt.Sprintf("%d.%d.%s", v.Major, v.Minor, v.Maintenance))
+		switch {
+		case strings.HasPrefix(v.Engineering, "prd"):
+			sb.WriteString(v.Engineering)
+		case v.Engineering != "":
+			sb.WriteString(fmt.Sprintf(".%s", v.Engineering))
+		}
+		if v.Build > 0 {
+			sb.WriteString(fmt.Sprintf(".0.%d", v.Build))
+		}
 		return sb.String()
 	}
 }
+
+// Train returns the release train, e.g. "17.9" for 17.9.4a and "3.16S" for 3.16.1aS
+func (v Version) Train() string {
+	if v.Major == 3 {
+		return fmt.Sprintf("%d.%d%s", v.Major, v.Minor, v.Release)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Canonical returns the full version string without zero padding, e.g. "17.9.4a" for "17.09.04a"
+func (v Version) Canonical() string {
+	v.Maintenance = natural.Canonical(v.Maintenance)
+	v.Engineering = natural.Canonical(v.Engineering)
+	return v.String()
+}
@@ -1,15 +1,19 @@
 package version_test
 
 import (
+	"cmp"
+	"errors"
 	"reflect"
 	"testing"
 
 	version "github.com/MaineK00n/go-cisco-version/ios-xe"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 func TestNewVersion(t *testing.T) {
 	type args struct {
-		ver string
+		ver  string
+		opts []parseopt.Options
 	}
 	tests := []struct {
 		name    string
@@ -76,10 +80,172 @@ func TestNewVersion(t *testing.T) {
 				Release:     "SG",
 			},
 		},
+		{
+			name: "strict 17.09.04a",
+			args: args{
+				ver: "17.09.04a",
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "04a",
+			},
+		},
+		{
+			name: "lenient Version 17.09.04a",
+			args: args{
+				ver:  "Version 17.09.04a",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "4a",
+			},
+		},
+		{
+			name: "lenient show version banner",
+			args: args{
+				ver:  "Cisco IOS XE Software, Version 17.09.04a",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "4a",
+			},
+		},
+		{
+			name: "lenient 17.9.4a (Cupertino)",
+			args: args{
+				ver:  "17.9.4a (Cupertino)",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "4a",
+			},
+		},
+		{
+			name: "lenient 03.16.08.S",
+			args: args{
+				ver:  "03.16.08.S",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Release:     "S",
+				Major:       3,
+				Minor:       16,
+				Maintenance: "8",
+			},
+		},
+		{
+			name: "strict 03.16.08.S",
+			args: args{
+				ver: "03.16.08.S",
+			},
+			wantErr: true,
+		},
+		{
+			name: "17.12.01prd7",
+			args: args{
+				ver: "17.12.01prd7",
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       12,
+				Maintenance: "01",
+				Engineering: "prd7",
+			},
+		},
+		{
+			name: "17.9.3.0.1234",
+			args: args{
+				ver: "17.9.3.0.1234",
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "3",
+				Build:       1234,
+			},
+		},
+		{
+			name: "16.12.5b.ES3",
+			args: args{
+				ver: "16.12.5b.ES3",
+			},
+			want: version.Version{
+				Major:       16,
+				Minor:       12,
+				Maintenance: "5b",
+				Engineering: "ES3",
+			},
+		},
+		{
+			name: "17.12.1prd7.0.12",
+			args: args{
+				ver: "17.12.1prd7.0.12",
+			},
+			want: version.Version{
+				Major:       17,
+				Minor:       12,
+				Maintenance: "1",
+				Engineering: "prd7",
+				Build:       12,
+			},
+		},
+		{
+			name: "17.9.3.0.0",
+			args: args{
+				ver: "17.9.3.0.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "17.9.3.0.x",
+			args: args{
+				ver: "17.9.3.0.x",
+			},
+			wantErr: true,
+		},
+		{
+			name: "3.16.8S.ES1",
+			args: args{
+				ver: "3.16.8S.ES1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "+3.0.A",
+			args: args{
+				ver: "+3.0.A",
+			},
+			wantErr: true,
+		},
+		{
+			name: "--1.0.",
+			args: args{
+				ver: "--1.0.",
+			},
+			wantErr: true,
+		},
+		{
+			name: "30.0.A",
+			args: args{
+				ver: "30.0.A",
+			},
+			want: version.Version{
+				Major:       30,
+				Minor:       0,
+				Maintenance: "A",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := version.NewVersion(tt.args.ver)
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -91,6 +257,66 @@ func TestNewVersion(t *testing.T) {
 	}
 }
 
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantInput  string
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "Everest-1x.5.1",
+			args:       args{ver: "Everest-1x.5.1"},
+			wantField:  "major",
+			wantOffset: 8,
+			wantLength: 2,
+		},
+		{
+			name:       "17.x.4a",
+			args:       args{ver: "17.x.4a"},
+			wantField:  "minor",
+			wantOffset: 3,
+			wantLength: 1,
+		},
+		{
+			name:       "lenient Version 17.x9.4",
+			args:       args{ver: " Version 17.x9.4 ", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			wantInput:  " Version 17.x9.4 ",
+			wantField:  version.FieldMinor,
+			wantOffset: 12,
+			wantLength: 2,
+		},
+		{
+			name:       "17.9",
+			args:       args{ver: "17.9"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver, tt.args.opts...)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if want := cmp.Or(tt.wantInput, tt.args.ver); perr.Input != want {
+				t.Errorf("NewVersion() error Input = %q, want %q", perr.Input, want)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
 func TestVersion_Compare(t *testing.T) {
 	type fields struct {
 		Release     string
@@ -141,6 +367,38 @@ func TestVersion_Compare(t *testing.T) {
 			},
 			want: +1,
 		},
+		{
+			name: "17.9.10 > 17.9.9",
+			fields: fields{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       17,
+					Minor:       9,
+					Maintenance: "9",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "17.9.4a < 17.9.10",
+			fields: fields{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "4a",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       17,
+					Minor:       9,
+					Maintenance: "10",
+				},
+			},
+			want: -1,
+		},
 		{
 			name: "3.16.1aS < 16.5.1",
 			fields: fields{
@@ -215,12 +473,181 @@ func TestVersion_Compare(t *testing.T) {
 	}
 }
 
+func TestVersion_Compare_Symmetric(t *testing.T) {
+	type args struct {
+		v1 string
+		v2 string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "3.18.1SP < 16.3.1",
+			args: args{v1: "3.18.1SP", v2: "16.3.1"},
+			want: -1,
+		},
+		{
+			name: "3.16.10S < Denali-16.3.1",
+			args: args{v1: "3.16.10S", v2: "Denali-16.3.1"},
+			want: -1,
+		},
+		{
+			name: "3.16.1 < 17.9.4a",
+			args: args{v1: "3.16.1", v2: "17.9.4a"},
+			want: -1,
+		},
+		{
+			name: "16.12.10 < 17.1.1",
+			args: args{v1: "16.12.10", v2: "17.1.1"},
+			want: -1,
+		},
+		{
+			name:    "3.16.1 vs 3.16.1S",
+			args:    args{v1: "3.16.1", v2: "3.16.1S"},
+			wantErr: true,
+		},
+		{
+			name: "3.18.1SP > 3.16.1S",
+			args: args{v1: "3.18.1SP", v2: "3.16.1S"},
+			want: +1,
+		},
+		{
+			name: "3.18.4S < 3.18.1SP",
+			args: args{v1: "3.18.4S", v2: "3.18.1SP"},
+			want: -1,
+		},
+		{
+			name: "3.4.8SG < 3.6.0E",
+			args: args{v1: "3.4.8SG", v2: "3.6.0E"},
+			want: -1,
+		},
+		{
+			name: "3.3.5SE < 3.6.0E",
+			args: args{v1: "3.3.5SE", v2: "3.6.0E"},
+			want: -1,
+		},
+		{
+			name:    "3.4.1SG vs 3.3.5SE",
+			args:    args{v1: "3.4.1SG", v2: "3.3.5SE"},
+			wantErr: true,
+		},
+		{
+			name:    "3.16.1S vs 3.6.0E",
+			args:    args{v1: "3.16.1S", v2: "3.6.0E"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+
+			got, err := v1.Compare(v2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Version.Compare() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			rgot, rerr := v2.Compare(v1)
+			if (rerr != nil) != tt.wantErr {
+				t.Errorf("reversed Version.Compare() error = %v, wantErr %v", rerr, tt.wantErr)
+				return
+			}
+			if got != tt.want || rgot != -tt.want {
+				t.Errorf("Version.Compare() = %v and reversed %v, want %v and %v", got, rgot, tt.want, -tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Compare_Engineering(t *testing.T) {
+	tests := []struct {
+		name string
+		v1   string
+		v2   string
+		want int
+	}{
+		{name: "17.12.1prd7 < 17.12.1", v1: "17.12.1prd7", v2: "17.12.1", want: -1},
+		{name: "17.12.1prd7 < 17.12.1prd10", v1: "17.12.1prd7", v2: "17.12.1prd10", want: -1},
+		{name: "17.12.1prd7 > 17.11.1", v1: "17.12.1prd7", v2: "17.11.1", want: +1},
+		{name: "17.12.01prd7 = 17.12.1prd7", v1: "17.12.01prd7", v2: "17.12.1prd7", want: 0},
+		{name: "17.9.3.0.1234 = 17.9.3", v1: "17.9.3.0.1234", v2: "17.9.3", want: 0},
+		{name: "17.9.3.0.1234 < 17.9.3a", v1: "17.9.3.0.1234", v2: "17.9.3a", want: -1},
+		{name: "17.9.3.0.1234 = 17.9.3.0.1240", v1: "17.9.3.0.1234", v2: "17.9.3.0.1240", want: 0},
+		{name: "17.09.04a.0.6 = 17.9.4a", v1: "17.09.04a.0.6", v2: "17.9.4a", want: 0},
+		{name: "17.12.1prd7.0.12 < 17.12.1", v1: "17.12.1prd7.0.12", v2: "17.12.1", want: -1},
+		{name: "16.12.5b.ES3 > 16.12.5b", v1: "16.12.5b.ES3", v2: "16.12.5b", want: +1},
+		{name: "16.12.5b.ES3 < 16.12.6", v1: "16.12.5b.ES3", v2: "16.12.6", want: -1},
+		{name: "16.12.5b.ES3 < 16.12.5b.ES10", v1: "16.12.5b.ES3", v2: "16.12.5b.ES10", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.v2)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, err := v1.Compare(v2)
+			if err != nil {
+				t.Fatalf("Version.Compare() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Version.Compare() = %v, want %v", got, tt.want)
+			}
+			if got := cmp.Compare(v1.SortKey(), v2.SortKey()); got != tt.want {
+				t.Errorf("cmp.Compare(Version.SortKey()) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_GA(t *testing.T) {
+	tests := []struct {
+		ver            string
+		wantGA         bool
+		wantPrerelease bool
+	}{
+		{ver: "17.12.1", wantGA: true},
+		{ver: "17.12.1prd7", wantPrerelease: true},
+		{ver: "17.9.3.0.1234", wantGA: true},
+		{ver: "17.09.04a.0.6", wantGA: true},
+		{ver: "16.12.5b.ES3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.GA(); got != tt.wantGA {
+				t.Errorf("Version.GA() = %v, want %v", got, tt.wantGA)
+			}
+			if got := v.Prerelease(); got != tt.wantPrerelease {
+				t.Errorf("Version.Prerelease() = %v, want %v", got, tt.wantPrerelease)
+			}
+		})
+	}
+}
+
 func TestVersion_String(t *testing.T) {
 	type fields struct {
 		Release     string
 		Major       int
 		Minor       int
 		Maintenance string
+		Engineering string
+		Build       int
 	}
 	tests := []struct {
 		name   string
@@ -257,6 +684,36 @@ func TestVersion_String(t *testing.T) {
 			},
 			want: "3.16.1aS",
 		},
+		{
+			name: "17.12.1prd7",
+			fields: fields{
+				Major:       17,
+				Minor:       12,
+				Maintenance: "1",
+				Engineering: "prd7",
+			},
+			want: "17.12.1prd7",
+		},
+		{
+			name: "16.12.5b.ES3",
+			fields: fields{
+				Major:       16,
+				Minor:       12,
+				Maintenance: "5b",
+				Engineering: "ES3",
+			},
+			want: "16.12.5b.ES3",
+		},
+		{
+			name: "17.9.3.0.1234",
+			fields: fields{
+				Major:       17,
+				Minor:       9,
+				Maintenance: "3",
+				Build:       1234,
+			},
+			want: "17.9.3.0.1234",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -265,6 +722,8 @@ func TestVersion_String(t *testing.T) {
 				Major:       tt.fields.Major,
 				Minor:       tt.fields.Minor,
 				Maintenance: tt.fields.Maintenance,
+				Engineering: tt.fields.Engineering,
+				Build:       tt.fields.Build,
 			}
 			if got := v.String(); got != tt.want {
 				t.Errorf("Version.String() = %v, want %v", got, tt.want)
@@ -272,3 +731,96 @@ func TestVersion_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "17.09.04a",
+			args:          args{ver: "17.09.04a"},
+			wantOriginal:  "17.09.04a",
+			wantCanonical: "17.9.4a",
+		},
+		{
+			name:          "Everest-16.5.1",
+			args:          args{ver: "Everest-16.5.1"},
+			wantOriginal:  "Everest-16.5.1",
+			wantCanonical: "Everest-16.5.1",
+		},
+		{
+			name:          "3.16.1aS",
+			args:          args{ver: "3.16.1aS"},
+			wantOriginal:  "3.16.1aS",
+			wantCanonical: "3.16.1aS",
+		},
+		{
+			name:          "lenient Version 17.09.04a",
+			args:          args{ver: "Version 17.09.04a", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			wantOriginal:  "Version 17.09.04a",
+			wantCanonical: "17.9.4a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Train(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "17.9.4a",
+			args: args{ver: "17.9.4a"},
+			want: "17.9",
+		},
+		{
+			name: "Everest-16.5.1",
+			args: args{ver: "Everest-16.5.1"},
+			want: "16.5",
+		},
+		{
+			name: "3.16.1aS",
+			args: args{ver: "3.16.1aS"},
+			want: "3.16S",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package version
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// Object is a Version marshaled as a JSON object exposing its fields instead of the canonical string
+type Object Version
+
+// MarshalText implements encoding.TextMarshaler with the canonical string
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.Canonical()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (v *Version) UnmarshalText(text []byte) error {
+	p, err := NewVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = p
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler with the canonical string
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Canonical())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both the canonical string and the Object form are accepted.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case bytes.HasPrefix(data, []byte("{")):
+		var o Object
+		if err := json.Unmarshal(data, &o); err != nil {
+			return err
+		}
+		*v = Version(o)
+		return nil
+	default:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return v.UnmarshalText([]byte(s))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler with the original string
+func (p Parsed) MarshalText() ([]byte, error) {
+	return []byte(p.Original()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The text is parsed strictly first, and leniently if that fails, since the original string may have been accepted only by parseopt.Lenient.
+func (p *Parsed) UnmarshalText(text []byte) error {
+	q, err := Parse(string(text))
+	if err != nil {
+		if q, err = Parse(string(text), parseopt.Options{Mode: parseopt.Lenient}); err != nil {
+			return err
+		}
+	}
+	*p = q
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler with the original string
+func (p Parsed) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Original())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Parsed) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return p.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,163 @@
+package version
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// GISO represents an IOS-XR Golden ISO (GISO) image
+// https://www.cisco.com/c/en/us/td/docs/iosxr/cisco8000/system-setup/b-system-setup-cr-cisco8000/m-customize-iso-image.html
+type GISO struct {
+	Version  Version
+	Label    string
+	Packages []string
+	SMUs     []string
+}
+
+var ddtsPattern = regexp.MustCompile(`CSC[a-z]{2}[0-9]{5}`)
+
+// NewGISOLabel returns a parsed GISO label such as "7.9.2-mycustom-v1" or "24.1.1 Version: 24.1.1-giso"
+func NewGISOLabel(label string) (GISO, error) {
+	s := strings.TrimSpace(label)
+	for _, key := range []string{"Version:", "Label:"} {
+		if i := strings.LastIndex(s, key); i >= 0 {
+			s = strings.TrimSpace(s[i+len(key):])
+		}
+	}
+	if fs := strings.Fields(s); len(fs) > 0 {
+		s = fs[len(fs)-1]
+	}
+
+	lhs, rhs, _ := strings.Cut(s, "-")
+	v, err := NewVersion(lhs)
+	if err != nil {
+		return GISO{}, fmt.Errorf("parse GISO label %q. expected: %q. err: %w", label, "<major|year>.<minor|quarter>.<release>(-<label>)", err)
+	}
+	return GISO{Version: v, Label: rhs}, nil
+}
+
+// ParseGISOManifest returns a GISO parsed from a GISO build manifest.
+// Both the gisobuild YAML configuration and the giso_info.txt text summary are accepted.
+func ParseGISOManifest(r io.Reader) (GISO, error) {
+	var (
+		g          GISO
+		iso, label string
+		found      bool
+		inList     bool
+		listIndent int
+	)
+
+	addPackage := func(p string) {
+		p = strings.Trim(strings.TrimSpace(p), `"'`)
+		if p == "" {
+			return
+		}
+		if ddtsPattern.MatchString(p) {
+			g.SMUs = append(g.SMUs, p)
+			return
+		}
+		g.Packages = append(g.Packages, p)
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		if inList {
+			if item, ok := strings.CutPrefix(trimmed, "- "); ok {
+				addPackage(item)
+				continue
+			}
+			if indent > listIndent && !strings.Contains(trimmed, ":") {
+				addPackage(trimmed)
+				continue
+			}
+			inList = false
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		switch normalizeGISOKey(key) {
+		case "version", "xrversion":
+			v, err := NewVersion(value)
+			if err != nil {
+				return GISO{}, fmt.Errorf("parse GISO version. err: %w", err)
+			}
+			g.Version = v
+			found = true
+		case "label", "gisolabel", "goldenisolabel":
+			label = value
+		case "iso":
+			iso = value
+		case "pkglist", "packages", "rpms", "goldenisorpms", "optionalpackages", "smus":
+			if strings.HasPrefix(value, "[") {
+				for _, p := range strings.Split(strings.Trim(value, "[]"), ",") {
+					addPackage(p)
+				}
+				continue
+			}
+			if value != "" {
+				for _, p := range strings.Fields(value) {
+					addPackage(p)
+				}
+				continue
+			}
+			inList, listIndent = true, indent
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return GISO{}, fmt.Errorf("read GISO manifest. err: %w", err)
+	}
+
+	if label != "" {
+		if l, err := NewGISOLabel(label); err == nil {
+			if !found {
+				g.Version, found = l.Version, true
+			}
+			label = l.Label
+		}
+		g.Label = label
+	}
+
+	if !found && iso != "" {
+		name := strings.TrimSuffix(path.Base(iso), ".iso")
+		if i := strings.LastIndex(name, "-"); i >= 0 {
+			if v, err := NewVersion(name[i+1:]); err == nil {
+				g.Version, found = v, true
+			}
+		}
+	}
+
+	if !found {
+		return GISO{}, fmt.Errorf("GISO manifest does not contain an IOS XR version")
+	}
+	return g, nil
+}
+
+func normalizeGISOKey(key string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(key))
+}
+
+// String returns the GISO label string
+func (g GISO) String() string {
+	if g.Label == "" {
+		return g.Version.String()
+	}
+	return fmt.Sprintf("%s-%s", g.Version, g.Label)
+}
@@ -0,0 +1,207 @@
+package version_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xr"
+)
+
+func TestNewGISOLabel(t *testing.T) {
+	type args struct {
+		label string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.GISO
+		wantErr bool
+	}{
+		{
+			name: "7.9.2-mycustom-v1",
+			args: args{
+				label: "7.9.2-mycustom-v1",
+			},
+			want: version.GISO{
+				Version: version.Version{Major: 7, Minor: 9, Release: 2},
+				Label:   "mycustom-v1",
+			},
+		},
+		{
+			name: "24.1.1 Version: 24.1.1-giso",
+			args: args{
+				label: "24.1.1 Version: 24.1.1-giso",
+			},
+			want: version.GISO{
+				Version: version.Version{Major: 24, Minor: 1, Release: 1},
+				Label:   "giso",
+			},
+		},
+		{
+			name: "7.3.2",
+			args: args{
+				label: "7.3.2",
+			},
+			want: version.GISO{
+				Version: version.Version{Major: 7, Minor: 3, Release: 2},
+			},
+		},
+		{
+			name: "mycustom-v1",
+			args: args{
+				label: "mycustom-v1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.NewGISOLabel(tt.args.label)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewGISOLabel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGISOLabel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGISOLabel_ParseError(t *testing.T) {
+	_, err := version.NewGISOLabel("7.x.2-mycustom-v1")
+	var perr *version.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("NewGISOLabel() error = %v, want *ParseError", err)
+	}
+	if perr.Field != "minor" {
+		t.Errorf("NewGISOLabel() error Field = %q, want %q", perr.Field, "minor")
+	}
+}
+
+func TestParseGISOManifest(t *testing.T) {
+	type args struct {
+		manifest string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.GISO
+		wantErr bool
+	}{
+		{
+			name: "gisobuild yaml",
+			args: args{
+				manifest: `---
+cli:
+  iso: /images/8000-x64-7.9.2.iso
+  repo:
+    - /images/rpms
+  pkglist:
+    - xr-bgp
+    - xr-mpls-oam
+    - CSCwd12345
+  label: mycustom-v1
+`,
+			},
+			want: version.GISO{
+				Version:  version.Version{Major: 7, Minor: 9, Release: 2},
+				Label:    "mycustom-v1",
+				Packages: []string{"xr-bgp", "xr-mpls-oam"},
+				SMUs:     []string{"CSCwd12345"},
+			},
+		},
+		{
+			name: "giso_info.txt",
+			args: args{
+				manifest: `Golden ISO Rpms:
+    xr-bgp-24.1.1v1.0.0-1.x86_64
+    xr-8000-24.1.1.CSCwh11111-1.0.0-1.x86_64
+Golden ISO Label: 24.1.1-giso
+XR-Version: 24.1.1
+`,
+			},
+			want: version.GISO{
+				Version:  version.Version{Major: 24, Minor: 1, Release: 1},
+				Label:    "giso",
+				Packages: []string{"xr-bgp-24.1.1v1.0.0-1.x86_64"},
+				SMUs:     []string{"xr-8000-24.1.1.CSCwh11111-1.0.0-1.x86_64"},
+			},
+		},
+		{
+			name: "inline list",
+			args: args{
+				manifest: `label: 7.9.2-lab
+pkglist: [xr-bgp, xr-isis]
+`,
+			},
+			want: version.GISO{
+				Version:  version.Version{Major: 7, Minor: 9, Release: 2},
+				Label:    "lab",
+				Packages: []string{"xr-bgp", "xr-isis"},
+			},
+		},
+		{
+			name: "no version",
+			args: args{
+				manifest: `pkglist:
+  - xr-bgp
+`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.ParseGISOManifest(strings.NewReader(tt.args.manifest))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseGISOManifest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseGISOManifest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGISO_String(t *testing.T) {
+	type fields struct {
+		Version version.Version
+		Label   string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "7.9.2-mycustom-v1",
+			fields: fields{
+				Version: version.Version{Major: 7, Minor: 9, Release: 2},
+				Label:   "mycustom-v1",
+			},
+			want: "7.9.2-mycustom-v1",
+		},
+		{
+			name: "7.9.2",
+			fields: fields{
+				Version: version.Version{Major: 7, Minor: 9, Release: 2},
+			},
+			want: "7.9.2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := version.GISO{
+				Version: tt.fields.Version,
+				Label:   tt.fields.Label,
+			}
+			if got := g.String(); got != tt.want {
+				t.Errorf("GISO.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package version
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "7.9.2" and "7.09.2", have the same Key.
+type Key struct {
+	Major   int
+	Minor   int
+	Release int
+}
+
+// Key returns the key of the version
+func (v Version) Key() Key {
+	return Key{
+		Major:   v.Major,
+		Minor:   v.Minor,
+		Release: v.Release,
+	}
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Major:   k.Major,
+		Minor:   k.Minor,
+		Release: k.Release,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
@@ -0,0 +1,107 @@
+package version
+
+import (
+	"time"
+)
+
+// Numbering represents the IOS-XR release numbering scheme
+type Numbering int
+
+const (
+	// NumberingLegacy is the <major>.<minor>.<release> scheme used up to 7.x
+	NumberingLegacy Numbering = iota
+	// NumberingCalendar is the <year>.<quarter>.<release> scheme used from 24.x
+	NumberingCalendar
+)
+
+// String returns the numbering scheme name
+func (n Numbering) String() string {
+	switch n {
+	case NumberingLegacy:
+		return "legacy"
+	case NumberingCalendar:
+		return "calendar"
+	default:
+		return "unknown"
+	}
+}
+
+// Support represents the IOS-XR release support duration
+type Support int
+
+const (
+	// SupportShort is a short-lived release
+	SupportShort Support = iota
+	// SupportLong is a long-lived release
+	SupportLong
+)
+
+// String returns the support duration name
+func (s Support) String() string {
+	switch s {
+	case SupportShort:
+		return "short"
+	case SupportLong:
+		return "long"
+	default:
+		return "unknown"
+	}
+}
+
+// firstCalendarMajor is the first major version numbered <year>.<quarter>.<release>
+const firstCalendarMajor = 24
+
+// Numbering returns the numbering scheme of the version
+func (v Version) Numbering() Numbering {
+	if v.Major >= firstCalendarMajor {
+		return NumberingCalendar
+	}
+	return NumberingLegacy
+}
+
+// Year returns the release year of a calendar numbered version
+func (v Version) Year() (int, bool) {
+	if v.Numbering() != NumberingCalendar {
+		return 0, false
+	}
+	return 2000 + v.Major, true
+}
+
+// Quarter returns the release quarter of a calendar numbered version
+func (v Version) Quarter() (int, bool) {
+	if v.Numbering() != NumberingCalendar || v.Minor < 1 || v.Minor > 4 {
+		return 0, false
+	}
+	return v.Minor, true
+}
+
+// GADate returns the estimated GA date of a calendar numbered version.
+// <year>.<quarter>.1 is estimated at the last month of the quarter, and each following release one quarter later.
+func (v Version) GADate() (time.Time, bool) {
+	year, ok := v.Year()
+	if !ok {
+		return time.Time{}, false
+	}
+	quarter, ok := v.Quarter()
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(quarter*3+max(v.Release-1, 0)*3), 1, 0, 0, 0, 0, time.UTC), true
+}
+
+// Support returns the support duration of the release train.
+// Legacy trains with an odd minor (7.3, 7.5, ...) and calendar trains with an even quarter (24.2, 24.4, ...) are long-lived.
+func (v Version) Support() Support {
+	switch v.Numbering() {
+	case NumberingCalendar:
+		if v.Minor%2 == 0 {
+			return SupportLong
+		}
+		return SupportShort
+	default:
+		if v.Minor%2 == 1 {
+			return SupportLong
+		}
+		return SupportShort
+	}
+}
@@ -0,0 +1,164 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	version "github.com/MaineK00n/go-cisco-version/ios-xr"
+)
+
+func TestVersion_Numbering(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want version.Numbering
+	}{
+		{
+			name: "7.11.1",
+			v:    version.Version{Major: 7, Minor: 11, Release: 1},
+			want: version.NumberingLegacy,
+		},
+		{
+			name: "24.1.1",
+			v:    version.Version{Major: 24, Minor: 1, Release: 1},
+			want: version.NumberingCalendar,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Numbering(); got != tt.want {
+				t.Errorf("Version.Numbering() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Year(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      version.Version
+		want   int
+		wantOK bool
+	}{
+		{
+			name: "7.9.2",
+			v:    version.Version{Major: 7, Minor: 9, Release: 2},
+		},
+		{
+			name:   "24.1.1",
+			v:      version.Version{Major: 24, Minor: 1, Release: 1},
+			want:   2024,
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.v.Year()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Version.Year() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_Quarter(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      version.Version
+		want   int
+		wantOK bool
+	}{
+		{
+			name: "7.9.2",
+			v:    version.Version{Major: 7, Minor: 9, Release: 2},
+		},
+		{
+			name:   "24.3.1",
+			v:      version.Version{Major: 24, Minor: 3, Release: 1},
+			want:   3,
+			wantOK: true,
+		},
+		{
+			name: "25.5.1",
+			v:    version.Version{Major: 25, Minor: 5, Release: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.v.Quarter()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Version.Quarter() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_GADate(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      version.Version
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name: "7.9.2",
+			v:    version.Version{Major: 7, Minor: 9, Release: 2},
+		},
+		{
+			name:   "24.1.1",
+			v:      version.Version{Major: 24, Minor: 1, Release: 1},
+			want:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "24.4.2",
+			v:      version.Version{Major: 24, Minor: 4, Release: 2},
+			want:   time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.v.GADate()
+			if !got.Equal(tt.want) || ok != tt.wantOK {
+				t.Errorf("Version.GADate() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVersion_Support(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want version.Support
+	}{
+		{
+			name: "7.9.2",
+			v:    version.Version{Major: 7, Minor: 9, Release: 2},
+			want: version.SupportLong,
+		},
+		{
+			name: "7.10.1",
+			v:    version.Version{Major: 7, Minor: 10, Release: 1},
+			want: version.SupportShort,
+		},
+		{
+			name: "24.1.1",
+			v:    version.Version{Major: 24, Minor: 1, Release: 1},
+			want: version.SupportShort,
+		},
+		{
+			name: "24.2.1",
+			v:    version.Version{Major: 24, Minor: 2, Release: 1},
+			want: version.SupportLong,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Support(); got != tt.want {
+				t.Errorf("Version.Support() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
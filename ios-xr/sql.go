@@ -0,0 +1,51 @@
+package version
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/MaineK00n/go-cisco-version/internal/sortkey"
+)
+
+// Scan implements sql.Scanner. A NULL value leaves the version unchanged.
+func (v *Version) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	default:
+		return fmt.Errorf("unexpected type to scan into Version. expected: %q, actual: %T", []string{"string", "[]byte"}, src)
+	}
+}
+
+// Value implements driver.Valuer with the canonical string
+func (v Version) Value() (driver.Value, error) {
+	return v.Canonical(), nil
+}
+
+// Scan implements sql.Scanner. A NULL value leaves the version unchanged.
+func (p *Parsed) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return p.UnmarshalText([]byte(src))
+	case []byte:
+		return p.UnmarshalText(src)
+	default:
+		return fmt.Errorf("unexpected type to scan into Parsed. expected: %q, actual: %T", []string{"string", "[]byte"}, src)
+	}
+}
+
+// Value implements driver.Valuer with the original string
+func (p Parsed) Value() (driver.Value, error) {
+	return p.Original(), nil
+}
+
+// SortKey returns a printable key whose lexical order matches Compare
+func (v Version) SortKey() string {
+	return sortkey.Int(v.Major) + sortkey.Int(v.Minor) + sortkey.Int(v.Release)
+}
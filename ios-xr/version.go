@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 // Version represents an IOS-XR version
@@ -16,23 +20,70 @@ type Version struct {
 	Release int
 }
 
+const platformName = "IOS XR"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat  = parseerr.FieldFormat
+	FieldMajor   = parseerr.FieldMajor
+	FieldMinor   = parseerr.FieldMinor
+	FieldRelease = parseerr.FieldRelease
+)
+
 // NewVersion returns a parsed version
-func NewVersion(ver string) (Version, error) {
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, nil)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+func parse(ver string) (Version, error) {
 	switch ss := strings.Split(ver, "."); len(ss) {
 	case 3:
 		major, err := strconv.Atoi(ss[0])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse major version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: parseerr.Offset(ss, 0), Length: len(ss[0]), Expected: "<number>", Err: err}
 		}
 
 		minor, err := strconv.Atoi(ss[1])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: parseerr.Offset(ss, 1), Length: len(ss[1]), Expected: "<number>", Err: err}
 		}
 
 		release, err := strconv.Atoi(ss[2])
 		if err != nil {
-			return Version{}, fmt.Errorf("parse release version. err: %w", err)
+			return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldRelease, Offset: parseerr.Offset(ss, 2), Length: len(ss[2]), Expected: "<number>", Err: err}
 		}
 
 		return Version{
@@ -41,7 +92,7 @@ func NewVersion(ver string) (Version, error) {
 			Release: release,
 		}, nil
 	default:
-		return Version{}, fmt.Errorf("unexpected IOS XR version format. expected: %q, actual: %q", "<major|year>.<minor|quarter>.<release>", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major|year>.<minor|quarter>.<release>"}
 	}
 }
 
@@ -59,3 +110,13 @@ func (v1 Version) Compare(v2 Version) int {
 func (v Version) String() string {
 	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Release)
 }
+
+// Train returns the release train, e.g. "7.9" for 7.9.2
+func (v Version) Train() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Canonical returns the full version string
+func (v Version) Canonical() string {
+	return v.String()
+}
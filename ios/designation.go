@@ -0,0 +1,120 @@
+package version
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Designation represents an IOS deployment designation
+type Designation string
+
+const (
+	// DesignationUnknown is used when the release is not in the dataset
+	DesignationUnknown Designation = ""
+	// DesignationED is Early Deployment
+	DesignationED Designation = "ED"
+	// DesignationGD is General Deployment
+	DesignationGD Designation = "GD"
+	// DesignationLD is Limited Deployment
+	DesignationLD Designation = "LD"
+)
+
+//go:embed designation.json
+var designationJSON []byte
+
+type designationData struct {
+	Trains   map[string]Designation `json:"trains"`
+	Releases map[string]Designation `json:"releases"`
+}
+
+var designations = sync.OnceValue(func() designationData {
+	var d designationData
+	if err := json.Unmarshal(designationJSON, &d); err != nil {
+		panic(fmt.Sprintf("unmarshal designation.json. err: %v", err))
+	}
+	return d
+})
+
+// Train returns the release train, e.g. "12.4T" for 12.4(24)T8
+func (v Version) Train() string {
+	return fmt.Sprintf("%d.%d%s", v.Major, v.Minor, v.Release)
+}
+
+// XTrain reports whether the version belongs to a special release (X) train, which branched off a parent train for particular hardware or features,
+// e.g. 12.4(2)XA and 12.2(25)XE off the 12.4 and 12.2 mainline, or 12.2(18)SXF and 12.2(33)SXJ off 12.2S
+func (v Version) XTrain() bool {
+	_, ok := parentRelease(v.Release)
+	return ok
+}
+
+// ParentTrain returns the train an X-train branched off, e.g. "12.4" for 12.4(2)XA and "12.2S" for 12.2(33)SXJ10, and "" if it is not an X-train
+func (v Version) ParentTrain() string {
+	r, ok := parentRelease(v.Release)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d%s", v.Major, v.Minor, r)
+}
+
+// parentRelease returns the release letter of the parent train of an X-train release, e.g. "" for XA and "S" for SXF
+func parentRelease(release string) (string, bool) {
+	switch {
+	case len(release) >= 2 && release[0] == 'X':
+		return "", true
+	case len(release) >= 3 && release[1] == 'X':
+		return release[:1], true
+	default:
+		return "", false
+	}
+}
+
+// related reports whether versions of the two releases are ordered by their feature releases.
+// An X-train is related only to its parent train and the other X-trains off it, e.g. SXJ to S and SXF but not to SE.
+func related(r1, r2 string) bool {
+	p1, x1 := parentRelease(r1)
+	p2, x2 := parentRelease(r2)
+	if !x1 && !x2 {
+		return true
+	}
+	if !x1 {
+		p1 = r1
+	}
+	if !x2 {
+		p2 = r2
+	}
+	return p1 == p2
+}
+
+// Designation returns the deployment designation of the version.
+// A designation given to the feature release (e.g. 12.2(55)SE) takes precedence over the one given to its train (e.g. 12.2SE).
+// Rebuilds and interim builds, e.g. 12.2(55a)SE or 12.2(55.1)SE, take the designation of the feature release.
+func (v Version) Designation() Designation {
+	d := designations()
+	if r, ok := d.Releases[fmt.Sprintf("%d.%d(%s)%s", v.Major, v.Minor, splitFeature(v.Feature).number, v.Release)]; ok {
+		return r
+	}
+	return d.Trains[v.Train()]
+}
+
+// Rebuild returns the rebuild number of the version, e.g. 1 for 12.4(3a) or 15.2(4)M6a, and 0 if it is not a rebuild
+func (v Version) Rebuild() int {
+	s := v.Feature
+	if v.Maintenance != "" {
+		s = v.Maintenance
+	}
+	_, letters := splitRebuild(s)
+	n := 0
+	for _, r := range letters {
+		n = n*26 + int(r-'a'+1)
+	}
+	return n
+}
+
+// splitRebuild splits a feature or maintenance string into the number and the lowercase rebuild letters
+func splitRebuild(s string) (string, string) {
+	i := strings.TrimRightFunc(s, func(r rune) bool { return 'a' <= r && r <= 'z' })
+	return i, s[len(i):]
+}
@@ -0,0 +1,179 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios"
+)
+
+func TestVersion_Train(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want string
+	}{
+		{
+			name: "12.4(25)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "25"},
+			want: "12.4",
+		},
+		{
+			name: "12.4(24)T8",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "24", Release: "T", Maintenance: "8"},
+			want: "12.4T",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_XTrain(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          version.Version
+		want       bool
+		wantParent string
+	}{
+		{
+			name:       "12.4(2)XA",
+			v:          version.Version{Major: 12, Minor: 4, Feature: "2", Release: "XA"},
+			want:       true,
+			wantParent: "12.4",
+		},
+		{
+			name:       "12.2(25)XE",
+			v:          version.Version{Major: 12, Minor: 2, Feature: "25", Release: "XE"},
+			want:       true,
+			wantParent: "12.2",
+		},
+		{
+			name:       "12.2(18)SXF17",
+			v:          version.Version{Major: 12, Minor: 2, Feature: "18", Release: "SXF", Maintenance: "17"},
+			want:       true,
+			wantParent: "12.2S",
+		},
+		{
+			name:       "12.2(33)SXJ10",
+			v:          version.Version{Major: 12, Minor: 2, Feature: "33", Release: "SXJ", Maintenance: "10"},
+			want:       true,
+			wantParent: "12.2S",
+		},
+		{
+			name: "12.2(55)SE12",
+			v:    version.Version{Major: 12, Minor: 2, Feature: "55", Release: "SE", Maintenance: "12"},
+			want: false,
+		},
+		{
+			name: "15.0(1)EX",
+			v:    version.Version{Major: 15, Minor: 0, Feature: "1", Release: "EX"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.XTrain(); got != tt.want {
+				t.Errorf("Version.XTrain() = %v, want %v", got, tt.want)
+			}
+			if got := tt.v.ParentTrain(); got != tt.wantParent {
+				t.Errorf("Version.ParentTrain() = %v, want %v", got, tt.wantParent)
+			}
+		})
+	}
+}
+
+func TestVersion_Designation(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want version.Designation
+	}{
+		{
+			name: "12.4(25f)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "25f"},
+			want: version.DesignationGD,
+		},
+		{
+			name: "12.4(24)T8",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "24", Release: "T", Maintenance: "8"},
+			want: version.DesignationED,
+		},
+		{
+			name: "12.2(55)SE12",
+			v:    version.Version{Major: 12, Minor: 2, Feature: "55", Release: "SE", Maintenance: "12"},
+			want: version.DesignationGD,
+		},
+		{
+			name: "12.2(35)SE5",
+			v:    version.Version{Major: 12, Minor: 2, Feature: "35", Release: "SE", Maintenance: "5"},
+			want: version.DesignationED,
+		},
+		{
+			name: "12.4(2)XA",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "2", Release: "XA"},
+			want: version.DesignationLD,
+		},
+		{
+			name: "12.2(55.1)SE",
+			v:    version.Version{Major: 12, Minor: 2, Feature: "55.1", Release: "SE"},
+			want: version.DesignationGD,
+		},
+		{
+			name: "15.0(1)EX",
+			v:    version.Version{Major: 15, Minor: 0, Feature: "1", Release: "EX"},
+			want: version.DesignationUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Designation(); got != tt.want {
+				t.Errorf("Version.Designation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Rebuild(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want int
+	}{
+		{
+			name: "12.4(3)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "3"},
+			want: 0,
+		},
+		{
+			name: "12.4(3a)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "3a"},
+			want: 1,
+		},
+		{
+			name: "12.4(25f)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "25f"},
+			want: 6,
+		},
+		{
+			name: "15.2(4)M6a",
+			v:    version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "6a"},
+			want: 1,
+		},
+		{
+			name: "15.2(4)M6",
+			v:    version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "6"},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Rebuild(); got != tt.want {
+				t.Errorf("Version.Rebuild() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package version
+
+import (
+	"cmp"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/sortkey"
+)
+
+// featurePattern matches a feature release number with optional interim build numbers and rebuild letters, e.g. "24", "25f", "24.6" or "4.0.55"
+var featurePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*[a-z]*$`)
+
+// feature is a feature release number split into its parts, e.g. "24.6a" -> {number: "24", interim: ["6"], rebuild: "a"}
+type feature struct {
+	number  string
+	interim []string
+	rebuild string
+}
+
+func splitFeature(s string) feature {
+	base, rebuild := splitRebuild(s)
+	number, rest, _ := strings.Cut(base, ".")
+	var interim []string
+	if rest != "" {
+		interim = strings.Split(rest, ".")
+	}
+	return feature{number: number, interim: interim, rebuild: rebuild}
+}
+
+// compareFeature orders feature release numbers by the number, the interim build numbers and the rebuild letters in turn.
+// A release sorts before its interim builds, e.g. 12.4(24)T < 12.4(24.6)T < 12.4(25)T,
+// and a rebuild sorts before the interim builds of the same number, e.g. 12.4(24a)T < 12.4(24.1)T,
+// since a rebuild patches the release it rebuilds while interim builds already carry the development toward the next feature release.
+// Rebuild letters are ordered by their count first, as the maintenance ones, e.g. 12.4(3z) < 12.4(3aa).
+func compareFeature(a, b string) int {
+	fa, fb := splitFeature(a), splitFeature(b)
+	if r := natural.Compare(fa.number, fb.number); r != 0 {
+		return r
+	}
+	for i := 0; i < len(fa.interim) && i < len(fb.interim); i++ {
+		if r := natural.Compare(fa.interim[i], fb.interim[i]); r != 0 {
+			return r
+		}
+	}
+	return cmp.Or(
+		cmp.Compare(len(fa.interim), len(fb.interim)),
+		cmp.Compare(len(fa.rebuild), len(fb.rebuild)),
+		cmp.Compare(fa.rebuild, fb.rebuild),
+	)
+}
+
+// featureSortKey returns the key of a feature release number, ordered as compareFeature
+func featureSortKey(s string) string {
+	f := splitFeature(s)
+	var sb strings.Builder
+	sb.WriteString(sortkey.Natural(f.number))
+	for _, n := range f.interim {
+		sb.WriteString("1")
+		sb.WriteString(sortkey.Natural(n))
+	}
+	sb.WriteString("0")
+	sb.WriteString(sortkey.Int(len(f.rebuild)))
+	sb.WriteString(sortkey.Text(f.rebuild))
+	return sb.String()
+}
+
+// FeatureNumber returns the feature release number without interim build numbers and rebuild letters, e.g. 24 for 12.4(24.6)T or 12.4(24a)
+func (v Version) FeatureNumber() int {
+	n, _ := strconv.Atoi(splitFeature(v.Feature).number)
+	return n
+}
+
+// Interim returns the interim build numbers of the version, e.g. [6] for 12.4(24.6)T or [0 55] for 15.2(4.0.55)E, and nil if it is not an interim build
+func (v Version) Interim() []int {
+	f := splitFeature(v.Feature)
+	if len(f.interim) == 0 {
+		return nil
+	}
+	ns := make([]int, 0, len(f.interim))
+	for _, s := range f.interim {
+		n, _ := strconv.Atoi(s)
+		ns = append(ns, n)
+	}
+	return ns
+}
@@ -0,0 +1,75 @@
+package version_test
+
+import (
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios"
+)
+
+func TestVersion_FeatureNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want int
+	}{
+		{
+			name: "12.4(25f)",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "25f"},
+			want: 25,
+		},
+		{
+			name: "12.4(24.6)T",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "24.6", Release: "T"},
+			want: 24,
+		},
+		{
+			name: "15.2(4.0.55)E",
+			v:    version.Version{Major: 15, Minor: 2, Feature: "4.0.55", Release: "E"},
+			want: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.FeatureNumber(); got != tt.want {
+				t.Errorf("Version.FeatureNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Interim(t *testing.T) {
+	tests := []struct {
+		name string
+		v    version.Version
+		want []int
+	}{
+		{
+			name: "12.4(24)T8",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "24", Release: "T", Maintenance: "8"},
+			want: nil,
+		},
+		{
+			name: "12.4(24.6)T",
+			v:    version.Version{Major: 12, Minor: 4, Feature: "24.6", Release: "T"},
+			want: []int{6},
+		},
+		{
+			name: "15.2(4.0.55)E",
+			v:    version.Version{Major: 15, Minor: 2, Feature: "4.0.55", Release: "E"},
+			want: []int{0, 55},
+		},
+		{
+			name: "12.2(33.1.13a)SXI",
+			v:    version.Version{Major: 12, Minor: 2, Feature: "33.1.13a", Release: "SXI"},
+			want: []int{1, 13},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Interim(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Interim() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
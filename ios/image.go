@@ -0,0 +1,65 @@
+package version
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Image represents an IOS software image such as "c2900-universalk9-mz.SPA.157-3.M8.bin"
+type Image struct {
+	Version    Version
+	Platform   string
+	FeatureSet string
+	Crypto     bool
+	NPE        bool
+}
+
+// NewImage returns a parsed IOS image name. A leading file system or directory such as "flash:/" is ignored.
+func NewImage(name string) (Image, error) {
+	base := strings.TrimSpace(name)
+	if _, rhs, ok := strings.Cut(base, ":"); ok {
+		base = rhs
+	}
+	base = strings.TrimSuffix(path.Base(base), ".bin")
+
+	head, rest, ok := strings.Cut(base, ".")
+	if !ok {
+		return Image{}, fmt.Errorf("unexpected IOS image name format. expected: %q, actual: %q", "<platform>-<feature set>-<format>(.SPA).<version>(.bin)", name)
+	}
+
+	ss := strings.Split(head, "-")
+	if len(ss) < 2 || ss[0] == "" || ss[1] == "" {
+		return Image{}, fmt.Errorf("unexpected IOS image name format. expected: %q, actual: %q", "<platform>-<feature set>-<format>(.SPA).<version>(.bin)", name)
+	}
+
+	rest = strings.TrimPrefix(rest, "SPA.")
+	v, err := newImageVersion(rest)
+	if err != nil {
+		return Image{}, fmt.Errorf("parse image version. err: %w", err)
+	}
+
+	featureSet := strings.ToLower(ss[1])
+	return Image{
+		Version:    v,
+		Platform:   strings.ToLower(ss[0]),
+		FeatureSet: featureSet,
+		Crypto:     strings.Contains(featureSet, "k9"),
+		NPE:        strings.Contains(featureSet, "npe"),
+	}, nil
+}
+
+// newImageVersion parses the version encoded in an image name, e.g. "157-3.M8" for 15.7(3)M8
+func newImageVersion(s string) (Version, error) {
+	lhs, rhs, ok := strings.Cut(s, "-")
+	if !ok || len(lhs) < 3 {
+		return Version{}, fmt.Errorf("unexpected IOS image version format. expected: %q, actual: %q", "<major><minor>-<feature>(.<release><maintenance>)", s)
+	}
+	if _, err := strconv.Atoi(lhs); err != nil {
+		return Version{}, fmt.Errorf("unexpected IOS image version format. expected: %q, actual: %q", "<major><minor>-<feature>(.<release><maintenance>)", s)
+	}
+
+	feature, train, _ := strings.Cut(rhs, ".")
+	return NewVersion(fmt.Sprintf("%s.%s(%s)%s", lhs[:2], lhs[2:], feature, train))
+}
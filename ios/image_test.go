@@ -0,0 +1,95 @@
+package version_test
+
+import (
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios"
+)
+
+func TestNewImage(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Image
+		wantErr bool
+	}{
+		{
+			name: "c2900-universalk9-mz.SPA.157-3.M8.bin",
+			args: args{
+				name: "c2900-universalk9-mz.SPA.157-3.M8.bin",
+			},
+			want: version.Image{
+				Version:    version.Version{Major: 15, Minor: 7, Feature: "3", Release: "M", Maintenance: "8"},
+				Platform:   "c2900",
+				FeatureSet: "universalk9",
+				Crypto:     true,
+			},
+		},
+		{
+			name: "flash:/c3750e-universalk9npe-mz.152-4.E10.bin",
+			args: args{
+				name: "flash:/c3750e-universalk9npe-mz.152-4.E10.bin",
+			},
+			want: version.Image{
+				Version:    version.Version{Major: 15, Minor: 2, Feature: "4", Release: "E", Maintenance: "10"},
+				Platform:   "c3750e",
+				FeatureSet: "universalk9npe",
+				Crypto:     true,
+				NPE:        true,
+			},
+		},
+		{
+			name: "c3560-ipbasek9-mz.122-55.SE12.bin",
+			args: args{
+				name: "c3560-ipbasek9-mz.122-55.SE12.bin",
+			},
+			want: version.Image{
+				Version:    version.Version{Major: 12, Minor: 2, Feature: "55", Release: "SE", Maintenance: "12"},
+				Platform:   "c3560",
+				FeatureSet: "ipbasek9",
+				Crypto:     true,
+			},
+		},
+		{
+			name: "c2960-lanbase-mz.122-55.SE",
+			args: args{
+				name: "c2960-lanbase-mz.122-55.SE",
+			},
+			want: version.Image{
+				Version:    version.Version{Major: 12, Minor: 2, Feature: "55", Release: "SE"},
+				Platform:   "c2960",
+				FeatureSet: "lanbase",
+			},
+		},
+		{
+			name: "c2900-universalk9-mz.bin",
+			args: args{
+				name: "c2900-universalk9-mz.bin",
+			},
+			wantErr: true,
+		},
+		{
+			name: "c2900.SPA.157-3.M8.bin",
+			args: args{
+				name: "c2900.SPA.157-3.M8.bin",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.NewImage(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewImage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewImage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package version
+
+import "github.com/MaineK00n/go-cisco-version/internal/natural"
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "15.2(4)M10" and "15.2(04)M010", have the same Key.
+type Key struct {
+	Major       int
+	Minor       int
+	Feature     string
+	Release     string
+	Maintenance string
+}
+
+// Key returns the key of the version, whose Feature and Maintenance have no zero padding
+func (v Version) Key() Key {
+	return Key{
+		Major:       v.Major,
+		Minor:       v.Minor,
+		Feature:     natural.Canonical(v.Feature),
+		Release:     v.Release,
+		Maintenance: natural.Canonical(v.Maintenance),
+	}
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Major:       k.Major,
+		Minor:       k.Minor,
+		Feature:     k.Feature,
+		Release:     k.Release,
+		Maintenance: k.Maintenance,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
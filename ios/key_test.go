@@ -0,0 +1,88 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "15.2(4)M10 == 15.2(04)M010",
+			args: args{
+				v1: "15.2(4)M10",
+				v2: "15.2(04)M010",
+			},
+			want: true,
+		},
+		{
+			name: "15.2(4)M10 == 15.2.4.M10 lenient",
+			args: args{
+				v1:   "15.2(4)M10",
+				v2:   "15.2.4.M10",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: true,
+		},
+		{
+			name: "15.2(4)M10 != 15.2(4)E10",
+			args: args{
+				v1: "15.2(4)M10",
+				v2: "15.2(4)E10",
+			},
+			want: false,
+		},
+		{
+			name: "12.4(3) != 12.4(3a)",
+			args: args{
+				v1: "12.4(3)",
+				v2: "12.4(3a)",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("15.2(04)M010")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "15.2(04)M010"}
+	if got := m[version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "10"}.Key()]; got != "15.2(04)M010" {
+		t.Errorf("map[Key] = %q, want %q", got, "15.2(04)M010")
+	}
+	want := version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "10"}
+	if got := v.Key().Version(); got != want {
+		t.Errorf("Key.Version() = %v, want %v", got, want)
+	}
+}
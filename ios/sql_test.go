@@ -0,0 +1,114 @@
+package version_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/ios"
+)
+
+func TestVersion_Scan(t *testing.T) {
+	type args struct {
+		src any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: args{src: "15.2(4)M10"},
+			want: version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "10"},
+		},
+		{
+			name: "[]byte",
+			args: args{src: []byte("15.2(4)M10")},
+			want: version.Version{Major: 15, Minor: 2, Feature: "4", Release: "M", Maintenance: "10"},
+		},
+		{
+			name: "nil",
+			args: args{src: nil},
+		},
+		{
+			name:    "int64",
+			args:    args{src: int64(1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got version.Version
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Version.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Value(t *testing.T) {
+	v := version.Version{Major: 15, Minor: 2, Feature: "04", Release: "M", Maintenance: "010"}
+	got, err := v.Value()
+	if err != nil {
+		t.Fatalf("Version.Value() error = %v", err)
+	}
+	if got != "15.2(4)M10" {
+		t.Errorf("Version.Value() = %v, want %v", got, "15.2(4)M10")
+	}
+}
+
+func TestVersion_SortKey(t *testing.T) {
+	var vs []version.Version
+	for _, s := range []string{
+		"12.4(25f)",
+		"12.4(3z)",
+		"12.4(3aa)",
+		"12.4(24)T",
+		"12.4(24)T8",
+		"12.4(24)T10",
+		"12.4(24a)T",
+		"12.4(24.6)T",
+		"12.4(24.10)T",
+		"12.2(18)SXF17",
+		"12.2(33)SXJ9",
+		"12.2(33)SXJ10",
+		"15.2(4)E",
+		"15.2(4.0.55)E",
+		"15.2(4.1)E",
+		"15.0(1)",
+		"15.0(1)M",
+		"15.0(1)M1",
+		"15.0(1)M1a",
+		"15.0(1)M1z",
+		"15.0(1)M1aa",
+		"15.0(1)M10",
+		"15.0(1)T",
+		"15.0(2)",
+		"15.2(4)M9",
+		"15.2(4)M10",
+	} {
+		v, err := version.NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+
+	for _, v1 := range vs {
+		for _, v2 := range vs {
+			want, err := v1.Compare(v2)
+			if err != nil {
+				continue
+			}
+			if got := cmp.Compare(v1.SortKey(), v2.SortKey()); got != want {
+				t.Errorf("cmp.Compare(%q.SortKey(), %q.SortKey()) = %d, want %d", v1, v2, got, want)
+			}
+		}
+	}
+}
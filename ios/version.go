@@ -6,6 +6,11 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 // Version represents an IOS version
@@ -18,31 +23,82 @@ type Version struct {
 	Maintenance string
 }
 
+const platformName = "IOS"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat  = parseerr.FieldFormat
+	FieldMajor   = parseerr.FieldMajor
+	FieldMinor   = parseerr.FieldMinor
+	FieldFeature = parseerr.FieldFeature
+)
+
 // NewVersion returns a parsed version
-func NewVersion(ver string) (Version, error) {
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, normalizeSeparator)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+func parse(ver string) (Version, error) {
 	lhs, rhs, ok := strings.Cut(ver, ".")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected IOS version format. expected: %q, actual: %q", "<major>.<minor>\\(<feature>\\)(<release><maintenance>)", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<feature>\\)(<release><maintenance>)"}
 	}
 	major, err := strconv.Atoi(lhs)
 	if err != nil {
-		return Version{}, fmt.Errorf("parse major version. err: %w", err)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: 0, Length: len(lhs), Expected: "<number>", Err: err}
 	}
+	off := len(lhs) + len(".")
 
 	lhs, rhs, ok = strings.Cut(rhs, "(")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected IOS version format. expected: %q, actual: %q", "<major>.<minor>\\(<feature>\\)(<release><maintenance>)", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<feature>\\)(<release><maintenance>)"}
 	}
 	minor, err := strconv.Atoi(lhs)
 	if err != nil {
-		return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: off, Length: len(lhs), Expected: "<number>", Err: err}
 	}
 
 	lhs, rhs, ok = strings.Cut(rhs, ")")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected IOS version format. expected: %q, actual: %q", "<major>.<minor>\\(<feature>\\)(<release><maintenance>)", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<feature>\\)(<release><maintenance>)"}
 	}
 	feature := lhs
+	if !featurePattern.MatchString(feature) {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFeature, Offset: strings.Index(ver, "(") + len("("), Length: len(feature), Expected: "<number>(.<interim>)(<rebuild>)"}
+	}
 
 	if rhs == "" {
 		return Version{Major: major, Minor: minor, Feature: feature}, nil
@@ -61,23 +117,51 @@ func NewVersion(ver string) (Version, error) {
 	return Version{Major: major, Minor: minor, Feature: feature, Release: release, Maintenance: maintenance}, nil
 }
 
+// normalizeSeparator rewrites the dotted form into the parenthesized one, e.g. "15.2.4" -> "15.2(4)" and "15.2.4.M10" -> "15.2(4)M10"
+func normalizeSeparator(ver string) string {
+	if strings.ContainsAny(ver, "()") {
+		return ver
+	}
+
+	ss := strings.Split(ver, ".")
+	switch {
+	case len(ss) == 3:
+		return fmt.Sprintf("%s.%s(%s)", ss[0], ss[1], ss[2])
+	case len(ss) == 4 && ss[3] != "" && unicode.IsUpper(rune(ss[3][0])):
+		return fmt.Sprintf("%s.%s(%s)%s", ss[0], ss[1], ss[2], ss[3])
+	default:
+		return ver
+	}
+}
+
 var ErrCannotCompareDifferentRelease = fmt.Errorf("cannot compare versions with different release types")
 
 // Compare returns an integer comparing two version.
 // The result will be 0 if v1==v2, -1 if v1 < v2, and +1 if v1 > v2.
+//
+// Maintenance releases are compared with their rebuild letters ordered by count first, e.g. 15.2(4)M6z < 15.2(4)M6aa, as Rebuild numbers them.
+// Feature release numbers are compared as described in compareFeature, so interim builds such as 12.4(24.6)T sort after 12.4(24)T and before 12.4(25)T.
+// Versions of different release trains are ordered by their feature releases, and ErrCannotCompareDifferentRelease is returned if those are the same.
+// An X-train is only ordered against its parent train and the other X-trains off it (see ParentTrain),
+// e.g. 12.2(33)SXJ10 against 12.2(33)S or 12.2(18)SXF17, while 12.2(33)SXJ10 and 12.2(55)SE12 return ErrCannotCompareDifferentRelease.
 func (v1 Version) Compare(v2 Version) (int, error) {
 	if r := cmp.Or(
 		cmp.Compare(v1.Major, v2.Major),
 		cmp.Compare(v1.Minor, v2.Minor),
-		cmp.Compare(v1.Feature, v2.Feature),
 	); r != 0 {
 		return r, nil
 	}
+	if !related(v1.Release, v2.Release) {
+		return 0, ErrCannotCompareDifferentRelease
+	}
+	if r := compareFeature(v1.Feature, v2.Feature); r != 0 {
+		return r, nil
+	}
 
 	if v1.Release != v2.Release {
 		return 0, ErrCannotCompareDifferentRelease
 	}
-	return cmp.Compare(v1.Maintenance, v2.Maintenance), nil
+	return natural.CompareRebuild(v1.Maintenance, v2.Maintenance), nil
 }
 
 // String returns the full version string
@@ -92,3 +176,10 @@ func (v Version) String() string {
 	}
 	return sb.String()
 }
+
+// Canonical returns the full version string without zero padding, e.g. "15.2(4)M10" for "15.2(04)M010"
+func (v Version) Canonical() string {
+	v.Feature = natural.Canonical(v.Feature)
+	v.Maintenance = natural.Canonical(v.Maintenance)
+	return v.String()
+}
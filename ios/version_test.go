@@ -1,15 +1,18 @@
 package version_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	version "github.com/MaineK00n/go-cisco-version/ios"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 func TestNewVersion(t *testing.T) {
 	type args struct {
-		ver string
+		ver  string
+		opts []parseopt.Options
 	}
 	tests := []struct {
 		name    string
@@ -84,10 +87,136 @@ func TestNewVersion(t *testing.T) {
 				Maintenance: "1a",
 			},
 		},
+		{
+			name: "lenient version 15.2(4)M10 ",
+			args: args{
+				ver:  "version 15.2(4)M10 ",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       15,
+				Minor:       2,
+				Feature:     "4",
+				Release:     "M",
+				Maintenance: "10",
+			},
+		},
+		{
+			name: "lenient show version banner",
+			args: args{
+				ver:  "Cisco IOS Software, C3750E Software (C3750E-UNIVERSALK9-M), Version 15.0(2)SE11, RELEASE SOFTWARE (fc3)",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       15,
+				Minor:       0,
+				Feature:     "2",
+				Release:     "SE",
+				Maintenance: "11",
+			},
+		},
+		{
+			name: "lenient 15.2.4.M10",
+			args: args{
+				ver:  "15.2.4.M10",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       15,
+				Minor:       2,
+				Feature:     "4",
+				Release:     "M",
+				Maintenance: "10",
+			},
+		},
+		{
+			name: "12.4(24.6)T",
+			args: args{
+				ver: "12.4(24.6)T",
+			},
+			want: version.Version{
+				Major:   12,
+				Minor:   4,
+				Feature: "24.6",
+				Release: "T",
+			},
+		},
+		{
+			name: "15.2(4.0.55)E",
+			args: args{
+				ver: "15.2(4.0.55)E",
+			},
+			want: version.Version{
+				Major:   15,
+				Minor:   2,
+				Feature: "4.0.55",
+				Release: "E",
+			},
+		},
+		{
+			name: "12.2(33)SXJ10",
+			args: args{
+				ver: "12.2(33)SXJ10",
+			},
+			want: version.Version{
+				Major:       12,
+				Minor:       2,
+				Feature:     "33",
+				Release:     "SXJ",
+				Maintenance: "10",
+			},
+		},
+		{
+			name: "12.2(18)SXF17",
+			args: args{
+				ver: "12.2(18)SXF17",
+			},
+			want: version.Version{
+				Major:       12,
+				Minor:       2,
+				Feature:     "18",
+				Release:     "SXF",
+				Maintenance: "17",
+			},
+		},
+		{
+			name: "11.3(11b)T2",
+			args: args{
+				ver: "11.3(11b)T2",
+			},
+			want: version.Version{
+				Major:       11,
+				Minor:       3,
+				Feature:     "11b",
+				Release:     "T",
+				Maintenance: "2",
+			},
+		},
+		{
+			name: "12.4(24.)T",
+			args: args{
+				ver: "12.4(24.)T",
+			},
+			wantErr: true,
+		},
+		{
+			name: "12.4(T)",
+			args: args{
+				ver: "12.4(T)",
+			},
+			wantErr: true,
+		},
+		{
+			name: "15.2()M",
+			args: args{
+				ver: "15.2()M",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := version.NewVersion(tt.args.ver)
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -99,6 +228,53 @@ func TestNewVersion(t *testing.T) {
 	}
 }
 
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "15.x(4)M10",
+			args:       args{ver: "15.x(4)M10"},
+			wantField:  "minor",
+			wantOffset: 3,
+			wantLength: 1,
+		},
+		{
+			name:       "12.4(24.x)T",
+			args:       args{ver: "12.4(24.x)T"},
+			wantField:  "feature",
+			wantOffset: 5,
+			wantLength: 4,
+		},
+		{
+			name:       "15",
+			args:       args{ver: "15"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
 func TestVersion_Compare(t *testing.T) {
 	type fields struct {
 		Major       int
@@ -226,6 +402,126 @@ func TestVersion_Compare(t *testing.T) {
 			},
 			want: -1,
 		},
+		{
+			name: "15.2(4)M6z < 15.2(4)M6aa",
+			fields: fields{
+				Major:       15,
+				Minor:       2,
+				Feature:     "4",
+				Release:     "M",
+				Maintenance: "6z",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       15,
+					Minor:       2,
+					Feature:     "4",
+					Release:     "M",
+					Maintenance: "6aa",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(3z) < 12.4(3aa)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "3z",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "3aa",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(3) < 12.4(3a)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "3",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "3a",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(3b) > 12.4(3a)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "3b",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "3a",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "12.4(3b) < 12.4(4)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "3b",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "4",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(9) < 12.4(10)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "9",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "10",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "15.2(4)M10 > 15.2(4)M9",
+			fields: fields{
+				Major:       15,
+				Minor:       2,
+				Feature:     "4",
+				Release:     "M",
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       15,
+					Minor:       2,
+					Feature:     "4",
+					Release:     "M",
+					Maintenance: "9",
+				},
+			},
+			want: +1,
+		},
 		{
 			name: "15.0(1) vs 15.0(1)M",
 			fields: fields{
@@ -261,6 +557,264 @@ func TestVersion_Compare(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "12.4(24)T < 12.4(24.6)T",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "24",
+				Release: "T",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "24.6",
+					Release: "T",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(24.6)T < 12.4(24.10)T",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "24.6",
+				Release: "T",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "24.10",
+					Release: "T",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(24.6)T < 12.4(25)T",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "24.6",
+				Release: "T",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "25",
+					Release: "T",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.4(24a)T < 12.4(24.1)T",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "24a",
+				Release: "T",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "24.1",
+					Release: "T",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "15.2(4.0.55)E > 15.2(4)E",
+			fields: fields{
+				Major:   15,
+				Minor:   2,
+				Feature: "4.0.55",
+				Release: "E",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   15,
+					Minor:   2,
+					Feature: "4",
+					Release: "E",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "15.2(4.0.55)E < 15.2(4.1)E",
+			fields: fields{
+				Major:   15,
+				Minor:   2,
+				Feature: "4.0.55",
+				Release: "E",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   15,
+					Minor:   2,
+					Feature: "4.1",
+					Release: "E",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.2(33)SXJ10 > 12.2(33)SXJ9",
+			fields: fields{
+				Major:       12,
+				Minor:       2,
+				Feature:     "33",
+				Release:     "SXJ",
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       12,
+					Minor:       2,
+					Feature:     "33",
+					Release:     "SXJ",
+					Maintenance: "9",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "12.2(18)SXF17 < 12.2(33)SXH",
+			fields: fields{
+				Major:       12,
+				Minor:       2,
+				Feature:     "18",
+				Release:     "SXF",
+				Maintenance: "17",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   2,
+					Feature: "33",
+					Release: "SXH",
+				},
+			},
+			want: -1,
+		},
+		{
+			name: "12.2(33)SXJ vs 12.2(33)SRE",
+			fields: fields{
+				Major:   12,
+				Minor:   2,
+				Feature: "33",
+				Release: "SXJ",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   2,
+					Feature: "33",
+					Release: "SRE",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "12.2(33)SXJ10 vs 12.2(55)SE12",
+			fields: fields{
+				Major:       12,
+				Minor:       2,
+				Feature:     "33",
+				Release:     "SXJ",
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       12,
+					Minor:       2,
+					Feature:     "55",
+					Release:     "SE",
+					Maintenance: "12",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "12.2(33)SXJ10 vs 12.2(31)SB",
+			fields: fields{
+				Major:       12,
+				Minor:       2,
+				Feature:     "33",
+				Release:     "SXJ",
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   2,
+					Feature: "31",
+					Release: "SB",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "12.2(33)SXJ10 > 12.2(31)S",
+			fields: fields{
+				Major:       12,
+				Minor:       2,
+				Feature:     "33",
+				Release:     "SXJ",
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   2,
+					Feature: "31",
+					Release: "S",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "12.4(2)XA vs 12.4(24)T",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "2",
+				Release: "XA",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "24",
+					Release: "T",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "12.4(2)XA < 12.4(3)",
+			fields: fields{
+				Major:   12,
+				Minor:   4,
+				Feature: "2",
+				Release: "XA",
+			},
+			args: args{
+				v2: version.Version{
+					Major:   12,
+					Minor:   4,
+					Feature: "3",
+				},
+			},
+			want: -1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -342,3 +896,46 @@ func TestVersion_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "15.2(04)M010",
+			args:          args{ver: "15.2(04)M010"},
+			wantOriginal:  "15.2(04)M010",
+			wantCanonical: "15.2(4)M10",
+		},
+		{
+			name:          "12.4(25f)",
+			args:          args{ver: "12.4(25f)"},
+			wantOriginal:  "12.4(25f)",
+			wantCanonical: "12.4(25f)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
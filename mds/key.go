@@ -0,0 +1,34 @@
+package version
+
+import "github.com/MaineK00n/go-cisco-version/internal/natural"
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "8.4(2f)" and "8.4(02f)", have the same Key.
+type Key struct {
+	Major       int
+	Minor       int
+	Maintenance string
+}
+
+// Key returns the key of the version, whose Maintenance has no zero padding
+func (v Version) Key() Key {
+	return Key{
+		Major:       v.Major,
+		Minor:       v.Minor,
+		Maintenance: natural.Canonical(v.Maintenance),
+	}
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Major:       k.Major,
+		Minor:       k.Minor,
+		Maintenance: k.Maintenance,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
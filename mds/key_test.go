@@ -0,0 +1,80 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/mds"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "8.4(2f) == 8.4(02f)",
+			args: args{
+				v1: "8.4(2f)",
+				v2: "8.4(02f)",
+			},
+			want: true,
+		},
+		{
+			name: "8.4(2f) == 8.4.2f lenient",
+			args: args{
+				v1:   "8.4(2f)",
+				v2:   "8.4.2f",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: true,
+		},
+		{
+			name: "8.4(2e) != 8.4(2f)",
+			args: args{
+				v1: "8.4(2e)",
+				v2: "8.4(2f)",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("8.4(02f)")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "8.4(02f)"}
+	if got := m[version.Version{Major: 8, Minor: 4, Maintenance: "2f"}.Key()]; got != "8.4(02f)" {
+		t.Errorf("map[Key] = %q, want %q", got, "8.4(02f)")
+	}
+	want := version.Version{Major: 8, Minor: 4, Maintenance: "2f"}
+	if got := v.Key().Version(); got != want {
+		t.Errorf("Key.Version() = %v, want %v", got, want)
+	}
+}
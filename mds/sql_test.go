@@ -0,0 +1,95 @@
+package version_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/mds"
+)
+
+func TestVersion_Scan(t *testing.T) {
+	type args struct {
+		src any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: args{src: "8.4(2f)"},
+			want: version.Version{Major: 8, Minor: 4, Maintenance: "2f"},
+		},
+		{
+			name: "[]byte",
+			args: args{src: []byte("8.4(2f)")},
+			want: version.Version{Major: 8, Minor: 4, Maintenance: "2f"},
+		},
+		{
+			name: "nil",
+			args: args{src: nil},
+		},
+		{
+			name:    "int64",
+			args:    args{src: int64(1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got version.Version
+			if err := got.Scan(tt.args.src); (err != nil) != tt.wantErr {
+				t.Errorf("Version.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Version.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Value(t *testing.T) {
+	v := version.Version{Major: 8, Minor: 4, Maintenance: "02f"}
+	got, err := v.Value()
+	if err != nil {
+		t.Fatalf("Version.Value() error = %v", err)
+	}
+	if got != "8.4(2f)" {
+		t.Errorf("Version.Value() = %v, want %v", got, "8.4(2f)")
+	}
+}
+
+func TestVersion_SortKey(t *testing.T) {
+	var vs []version.Version
+	for _, s := range []string{
+		"3.3(1a)",
+		"3.3(1c)",
+		"3.3(5)",
+		"6.2(29)",
+		"6.2(33)",
+		"8.4(2)",
+		"8.4(2c)",
+		"8.4(2f)",
+		"8.4(10)",
+		"9.3(2a)",
+		"9.4(1)",
+	} {
+		v, err := version.NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) error = %v", s, err)
+		}
+		vs = append(vs, v)
+	}
+
+	for _, v1 := range vs {
+		for _, v2 := range vs {
+			if got, want := cmp.Compare(v1.SortKey(), v2.SortKey()), v1.Compare(v2); got != want {
+				t.Errorf("cmp.Compare(%q.SortKey(), %q.SortKey()) = %d, want %d", v1, v2, got, want)
+			}
+		}
+	}
+}
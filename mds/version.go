@@ -0,0 +1,150 @@
+package version
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+// Version represents a MDS 9000 NX-OS or SAN-OS version, e.g. 9.3(2a) or 3.3(1c).
+// MDS releases have their own trains and release catalog, so 9.3(2a) of MDS is unrelated to 9.3(x) of Nexus switches in the nx-os package.
+// https://www.cisco.com/c/en/us/support/storage-networking/mds-9000-nx-os-san-os-software/series.html
+type Version struct {
+	Major       int
+	Minor       int
+	Maintenance string
+}
+
+const platformName = "MDS NX-OS"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat      = parseerr.FieldFormat
+	FieldMajor       = parseerr.FieldMajor
+	FieldMinor       = parseerr.FieldMinor
+	FieldMaintenance = parseerr.FieldMaintenance
+)
+
+// NewVersion returns a parsed version
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, normalizeSeparator)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+// maintenancePattern matches a maintenance release with optional rebuild letters, e.g. "2" or "2f"
+var maintenancePattern = regexp.MustCompile(`^[0-9]+[a-z]*$`)
+
+func parse(ver string) (Version, error) {
+	lhs, rhs, ok := strings.Cut(ver, ".")
+	if !ok {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)"}
+	}
+	major, err := strconv.Atoi(lhs)
+	if err != nil {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: 0, Length: len(lhs), Expected: "<number>", Err: err}
+	}
+	off := len(lhs) + len(".")
+
+	lhs, rhs, ok = strings.Cut(rhs, "(")
+	if !ok {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)"}
+	}
+	minor, err := strconv.Atoi(lhs)
+	if err != nil {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: off, Length: len(lhs), Expected: "<number>", Err: err}
+	}
+	off += len(lhs) + len("(")
+
+	maintenance, found := strings.CutSuffix(rhs, ")")
+	if !found {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)"}
+	}
+	if !maintenancePattern.MatchString(maintenance) {
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMaintenance, Offset: off, Length: len(maintenance), Expected: "<number>(<rebuild>)"}
+	}
+
+	return Version{Major: major, Minor: minor, Maintenance: maintenance}, nil
+}
+
+// normalizeSeparator rewrites the dotted form into the parenthesized one, e.g. "8.4.2f" -> "8.4(2f)"
+func normalizeSeparator(ver string) string {
+	if strings.ContainsAny(ver, "()") {
+		return ver
+	}
+
+	ss := strings.Split(ver, ".")
+	if len(ss) != 3 {
+		return ver
+	}
+	return fmt.Sprintf("%s.%s(%s)", ss[0], ss[1], ss[2])
+}
+
+// Compare returns an integer comparing two version.
+// The result will be 0 if v1==v2, -1 if v1 < v2, and +1 if v1 > v2.
+func (v1 Version) Compare(v2 Version) int {
+	return cmp.Or(
+		cmp.Compare(v1.Major, v2.Major),
+		cmp.Compare(v1.Minor, v2.Minor),
+		natural.Compare(v1.Maintenance, v2.Maintenance),
+	)
+}
+
+// String returns the full version string
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d(%s)", v.Major, v.Minor, v.Maintenance)
+}
+
+// Train returns the release train, e.g. "8.4" for 8.4(2f)
+func (v Version) Train() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// SANOS reports whether the version is a SAN-OS release, which MDS ran before NX-OS 4.1, e.g. 3.3(1c)
+func (v Version) SANOS() bool {
+	return v.Major < 4 || v.Major == 4 && v.Minor < 1
+}
+
+// Canonical returns the full version string without zero padding, e.g. "8.4(2f)" for "8.4(02f)"
+func (v Version) Canonical() string {
+	v.Maintenance = natural.Canonical(v.Maintenance)
+	return v.String()
+}
@@ -0,0 +1,318 @@
+package version_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/mds"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestNewVersion(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    version.Version
+		wantErr bool
+	}{
+		{
+			name: "8.4(2f)",
+			args: args{ver: "8.4(2f)"},
+			want: version.Version{Major: 8, Minor: 4, Maintenance: "2f"},
+		},
+		{
+			name: "9.3(2a)",
+			args: args{ver: "9.3(2a)"},
+			want: version.Version{Major: 9, Minor: 3, Maintenance: "2a"},
+		},
+		{
+			name: "6.2(33)",
+			args: args{ver: "6.2(33)"},
+			want: version.Version{Major: 6, Minor: 2, Maintenance: "33"},
+		},
+		{
+			name: "3.3(1c)",
+			args: args{ver: "3.3(1c)"},
+			want: version.Version{Major: 3, Minor: 3, Maintenance: "1c"},
+		},
+		{
+			name: "lenient version 8.4(02f)",
+			args: args{ver: "version 8.4(02f)", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			want: version.Version{Major: 8, Minor: 4, Maintenance: "2f"},
+		},
+		{
+			name: "lenient 8.4.2f",
+			args: args{ver: "8.4.2f", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			want: version.Version{Major: 8, Minor: 4, Maintenance: "2f"},
+		},
+		{
+			name:    "strict 8.4.2f",
+			args:    args{ver: "8.4.2f"},
+			wantErr: true,
+		},
+		{
+			name:    "7.0(3)I7(9)",
+			args:    args{ver: "7.0(3)I7(9)"},
+			wantErr: true,
+		},
+		{
+			name:    "8.4()",
+			args:    args{ver: "8.4()"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "8.x(2f)",
+			args:       args{ver: "8.x(2f)"},
+			wantField:  "minor",
+			wantOffset: 2,
+			wantLength: 1,
+		},
+		{
+			name:       "8.4(2F)",
+			args:       args{ver: "8.4(2F)"},
+			wantField:  "maintenance",
+			wantOffset: 4,
+			wantLength: 2,
+		},
+		{
+			name:       "8.4",
+			args:       args{ver: "8.4"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	type fields struct {
+		Major       int
+		Minor       int
+		Maintenance string
+	}
+	type args struct {
+		v2 version.Version
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   int
+	}{
+		{
+			name:   "8.4(2f) = 8.4(02f)",
+			fields: fields{Major: 8, Minor: 4, Maintenance: "2f"},
+			args:   args{v2: version.Version{Major: 8, Minor: 4, Maintenance: "02f"}},
+			want:   0,
+		},
+		{
+			name:   "8.4(2e) < 8.4(2f)",
+			fields: fields{Major: 8, Minor: 4, Maintenance: "2e"},
+			args:   args{v2: version.Version{Major: 8, Minor: 4, Maintenance: "2f"}},
+			want:   -1,
+		},
+		{
+			name:   "8.4(2) < 8.4(2a)",
+			fields: fields{Major: 8, Minor: 4, Maintenance: "2"},
+			args:   args{v2: version.Version{Major: 8, Minor: 4, Maintenance: "2a"}},
+			want:   -1,
+		},
+		{
+			name:   "8.4(2f) < 8.4(10)",
+			fields: fields{Major: 8, Minor: 4, Maintenance: "2f"},
+			args:   args{v2: version.Version{Major: 8, Minor: 4, Maintenance: "10"}},
+			want:   -1,
+		},
+		{
+			name:   "9.3(2a) > 8.4(2f)",
+			fields: fields{Major: 9, Minor: 3, Maintenance: "2a"},
+			args:   args{v2: version.Version{Major: 8, Minor: 4, Maintenance: "2f"}},
+			want:   +1,
+		},
+		{
+			name:   "3.3(1c) < 4.1(1b)",
+			fields: fields{Major: 3, Minor: 3, Maintenance: "1c"},
+			args:   args{v2: version.Version{Major: 4, Minor: 1, Maintenance: "1b"}},
+			want:   -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1 := version.Version{
+				Major:       tt.fields.Major,
+				Minor:       tt.fields.Minor,
+				Maintenance: tt.fields.Maintenance,
+			}
+			if got := v1.Compare(tt.args.v2); got != tt.want {
+				t.Errorf("Version.Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	type fields struct {
+		Major       int
+		Minor       int
+		Maintenance string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name:   "8.4(2f)",
+			fields: fields{Major: 8, Minor: 4, Maintenance: "2f"},
+			want:   "8.4(2f)",
+		},
+		{
+			name:   "6.2(33)",
+			fields: fields{Major: 6, Minor: 2, Maintenance: "33"},
+			want:   "6.2(33)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := version.Version{
+				Major:       tt.fields.Major,
+				Minor:       tt.fields.Minor,
+				Maintenance: tt.fields.Maintenance,
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("Version.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "8.4(02f)",
+			args:          args{ver: "8.4(02f)"},
+			wantOriginal:  "8.4(02f)",
+			wantCanonical: "8.4(2f)",
+		},
+		{
+			name:          "lenient 8.4.2f",
+			args:          args{ver: "8.4.2f", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			wantOriginal:  "8.4.2f",
+			wantCanonical: "8.4(2f)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Train(t *testing.T) {
+	tests := []struct {
+		ver  string
+		want string
+	}{
+		{ver: "8.4(2f)", want: "8.4"},
+		{ver: "9.3(2a)", want: "9.3"},
+		{ver: "3.3(1c)", want: "3.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_SANOS(t *testing.T) {
+	tests := []struct {
+		ver  string
+		want bool
+	}{
+		{ver: "3.3(1c)", want: true},
+		{ver: "3.3(5b)", want: true},
+		{ver: "4.1(1b)", want: false},
+		{ver: "8.4(2f)", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.SANOS(); got != tt.want {
+				t.Errorf("Version.SANOS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
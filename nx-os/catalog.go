@@ -0,0 +1,60 @@
+package version
+
+import (
+	_ "embed"
+	"slices"
+	"sync"
+
+	"github.com/MaineK00n/go-cisco-version/internal/catalog"
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+)
+
+//go:embed releases.json
+var releasesJSON []byte
+
+var releases = sync.OnceValue(func() catalog.Catalog[Version] {
+	return catalog.Load(releasesJSON, func(s string) (Version, error) { return NewVersion(s) })
+})
+
+// CatalogVersion returns the version of the embedded release catalog
+func CatalogVersion() string {
+	return releases().Version
+}
+
+// Releases returns the releases in the embedded release catalog in ascending order
+func Releases() []Version {
+	return slices.Clone(releases().Releases)
+}
+
+// Known reports whether v is a release in the embedded release catalog
+func Known(v Version) bool {
+	return catalog.Known(releases().Releases, v)
+}
+
+// Next returns the release following v on the same train in the release catalog
+func (v Version) Next() (Version, bool) {
+	return catalog.Next(releases().Releases, v, Version.Compare, func(r Version) bool { return r.Train() == v.Train() })
+}
+
+// Prev returns the release preceding v on the same train in the release catalog
+func (v Version) Prev() (Version, bool) {
+	return catalog.Prev(releases().Releases, v, Version.Compare, func(r Version) bool { return r.Train() == v.Train() })
+}
+
+// NextMaintenance returns the next maintenance release on the same train in the release catalog, skipping rebuilds of v, e.g. 9.3(9) for 9.3(8)
+func (v Version) NextMaintenance() (Version, bool) {
+	return catalog.Next(releases().Releases, v, Version.Compare, func(r Version) bool {
+		if r.Train() != v.Train() {
+			return false
+		}
+		if v.Platform != "" {
+			return natural.Compare(natural.Base(r.PlatformMaintenance), natural.Base(v.PlatformMaintenance)) > 0
+		}
+		return natural.Compare(natural.Base(r.Maintenance), natural.Base(v.Maintenance)) > 0
+	})
+}
+
+// NextMinor returns the first release of the next train in the release catalog, e.g. 10.1(1) for 9.3(13)
+func (v Version) NextMinor() (Version, bool) {
+	return catalog.Next(releases().Releases, v, Version.Compare, func(r Version) bool { return r.Train() != v.Train() && r.Platform == v.Platform })
+}
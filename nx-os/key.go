@@ -0,0 +1,43 @@
+package version
+
+import "github.com/MaineK00n/go-cisco-version/internal/natural"
+
+// Key is a comparable form of a Version that is usable as a map key.
+// Versions that are the same release, such as "9.3(8)" and "9.3(08)", have the same Key.
+type Key struct {
+	Major               int
+	Minor               int
+	Maintenance         string
+	Platform            string
+	PlatformMinor       int
+	PlatformMaintenance string
+}
+
+// Key returns the key of the version, whose Maintenance and PlatformMaintenance have no zero padding
+func (v Version) Key() Key {
+	return Key{
+		Major:               v.Major,
+		Minor:               v.Minor,
+		Maintenance:         natural.Canonical(v.Maintenance),
+		Platform:            v.Platform,
+		PlatformMinor:       v.PlatformMinor,
+		PlatformMaintenance: natural.Canonical(v.PlatformMaintenance),
+	}
+}
+
+// Version returns the version of the key
+func (k Key) Version() Version {
+	return Version{
+		Major:               k.Major,
+		Minor:               k.Minor,
+		Maintenance:         k.Maintenance,
+		Platform:            k.Platform,
+		PlatformMinor:       k.PlatformMinor,
+		PlatformMaintenance: k.PlatformMaintenance,
+	}
+}
+
+// Equal reports whether v and w are the same release, regardless of how they were written
+func (v Version) Equal(w Version) bool {
+	return v.Key() == w.Key()
+}
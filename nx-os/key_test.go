@@ -0,0 +1,79 @@
+package version_test
+
+import (
+	"testing"
+
+	version "github.com/MaineK00n/go-cisco-version/nx-os"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "9.3(8) == 9.3(08)",
+			args: args{
+				v1: "9.3(8)",
+				v2: "9.3(08)",
+			},
+			want: true,
+		},
+		{
+			name: "7.0(3)I7(9) == 7.0(03)I7(09)",
+			args: args{
+				v1: "7.0(3)I7(9)",
+				v2: "7.0(03)I7(09)",
+			},
+			want: true,
+		},
+		{
+			name: "7.0(3)I7(9) != 7.0(3)I7(10)",
+			args: args{
+				v1: "7.0(3)I7(9)",
+				v2: "7.0(3)I7(10)",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("9.3(08)")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "9.3(08)"}
+	if got := m[version.Version{Major: 9, Minor: 3, Maintenance: "8"}.Key()]; got != "9.3(08)" {
+		t.Errorf("map[Key] = %q, want %q", got, "9.3(08)")
+	}
+	want := version.Version{Major: 9, Minor: 3, Maintenance: "8"}
+	if got := v.Key().Version(); got != want {
+		t.Errorf("Key.Version() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,112 @@
+package version
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Lifetime represents whether a NX-OS release train is long-lived or short-lived
+type Lifetime string
+
+const (
+	// LifetimeUnknown is used when the train is not in the dataset
+	LifetimeUnknown Lifetime = ""
+	// LifetimeLong is a long-lived release, e.g. 9.3 or 10.4, which receives maintenance releases for several years
+	LifetimeLong Lifetime = "long"
+	// LifetimeShort is a short-lived feature release, e.g. 10.3, which is superseded by the next release
+	LifetimeShort Lifetime = "short"
+)
+
+// Lifecycle represents the classification and support horizon of a release train
+type Lifecycle struct {
+	Train    string
+	Lifetime Lifetime
+	// Recommended is the recommended maintenance release of the train, or the zero Version if there is none
+	Recommended  Version
+	FirstRelease time.Time
+	EndOfSupport time.Time
+}
+
+//go:embed lifecycle.json
+var lifecycleJSON []byte
+
+var lifecycles = sync.OnceValue(func() map[string]Lifecycle {
+	var d struct {
+		Version string `json:"version"`
+		Trains  map[string]struct {
+			Lifetime     Lifetime `json:"lifetime"`
+			Recommended  string   `json:"recommended"`
+			FirstRelease string   `json:"first_release"`
+			EndOfSupport string   `json:"end_of_support"`
+		} `json:"trains"`
+	}
+	if err := json.Unmarshal(lifecycleJSON, &d); err != nil {
+		panic(fmt.Sprintf("unmarshal lifecycle.json. err: %v", err))
+	}
+
+	m := make(map[string]Lifecycle, len(d.Trains))
+	for train, t := range d.Trains {
+		l := Lifecycle{Train: train, Lifetime: t.Lifetime}
+		if t.Recommended != "" {
+			v, err := NewVersion(t.Recommended)
+			if err != nil {
+				panic(fmt.Sprintf("parse recommended release of train %q in lifecycle.json. err: %v", train, err))
+			}
+			if v.Train() != train {
+				panic(fmt.Sprintf("recommended release %q is not on train %q in lifecycle.json", t.Recommended, train))
+			}
+			l.Recommended = v
+		}
+		for _, f := range []struct {
+			s   string
+			dst *time.Time
+		}{
+			{s: t.FirstRelease, dst: &l.FirstRelease},
+			{s: t.EndOfSupport, dst: &l.EndOfSupport},
+		} {
+			tm, err := time.Parse(time.DateOnly, f.s)
+			if err != nil {
+				panic(fmt.Sprintf("parse date of train %q in lifecycle.json. err: %v", train, err))
+			}
+			*f.dst = tm
+		}
+		m[train] = l
+	}
+	return m
+})
+
+// Lifecycle returns the classification and support horizon of the train of the version
+func (v Version) Lifecycle() (Lifecycle, bool) {
+	l, ok := lifecycles()[v.Train()]
+	return l, ok
+}
+
+// Lifetime returns whether the train of the version is long-lived or short-lived
+func (v Version) Lifetime() Lifetime {
+	return lifecycles()[v.Train()].Lifetime
+}
+
+// LongLivedTrain returns the long-lived train the version belongs to, e.g. "9.3" for 9.3(8) and "7.0(3)I7" for 7.0(3)I7(9),
+// and false if the train of the version is short-lived or not in the dataset
+func (v Version) LongLivedTrain() (string, bool) {
+	l, ok := v.Lifecycle()
+	if !ok || l.Lifetime != LifetimeLong {
+		return "", false
+	}
+	return l.Train, true
+}
+
+// Recommended reports whether the version is the recommended maintenance release of its train
+func (v Version) Recommended() bool {
+	l, ok := v.Lifecycle()
+	return ok && l.Recommended != (Version{}) && v.Equal(l.Recommended)
+}
+
+// EndOfSupport returns the last date of support of the train of the version, and false if the train is not in the dataset
+func (v Version) EndOfSupport() (time.Time, bool) {
+	l, ok := v.Lifecycle()
+	return l.EndOfSupport, ok
+}
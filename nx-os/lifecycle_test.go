@@ -0,0 +1,217 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	version "github.com/MaineK00n/go-cisco-version/nx-os"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+)
+
+func TestVersion_Lifecycle(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   version.Lifecycle
+		wantOk bool
+	}{
+		{
+			name: "9.3(8)",
+			args: args{ver: "9.3(8)"},
+			want: version.Lifecycle{
+				Train:        "9.3",
+				Lifetime:     version.LifetimeLong,
+				Recommended:  version.Version{Major: 9, Minor: 3, Maintenance: "13"},
+				FirstRelease: time.Date(2019, time.August, 6, 0, 0, 0, 0, time.UTC),
+				EndOfSupport: time.Date(2025, time.October, 31, 0, 0, 0, 0, time.UTC),
+			},
+			wantOk: true,
+		},
+		{
+			name: "10.3(2)",
+			args: args{ver: "10.3(2)"},
+			want: version.Lifecycle{
+				Train:        "10.3",
+				Lifetime:     version.LifetimeShort,
+				FirstRelease: time.Date(2022, time.August, 19, 0, 0, 0, 0, time.UTC),
+				EndOfSupport: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+			wantOk: true,
+		},
+		{
+			name: "6.0(2)A8(11)",
+			args: args{ver: "6.0(2)A8(11)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.Lifecycle()
+			if ok != tt.wantOk {
+				t.Errorf("Version.Lifecycle() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if got.Train != tt.want.Train || got.Lifetime != tt.want.Lifetime || !got.Recommended.Equal(tt.want.Recommended) || !got.FirstRelease.Equal(tt.want.FirstRelease) || !got.EndOfSupport.Equal(tt.want.EndOfSupport) {
+				t.Errorf("Version.Lifecycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Lifetime(t *testing.T) {
+	tests := []struct {
+		ver  string
+		want version.Lifetime
+	}{
+		{ver: "7.0(3)I7(9)", want: version.LifetimeLong},
+		{ver: "9.3(8)", want: version.LifetimeLong},
+		{ver: "10.2(5)", want: version.LifetimeLong},
+		{ver: "10.4(1)", want: version.LifetimeLong},
+		{ver: "9.2(4)", want: version.LifetimeShort},
+		{ver: "10.1(2)", want: version.LifetimeShort},
+		{ver: "10.3(6)", want: version.LifetimeShort},
+		{ver: "10.5(1)", want: version.LifetimeShort},
+		{ver: "7.0(3)I4(8)", want: version.LifetimeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Lifetime(); got != tt.want {
+				t.Errorf("Version.Lifetime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_LongLivedTrain(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "7.0(3)I7(9)",
+			args:   args{ver: "7.0(3)I7(9)"},
+			want:   "7.0(3)I7",
+			wantOk: true,
+		},
+		{
+			name:   "lenient 7.0.3.I7.10",
+			args:   args{ver: "7.0.3.I7.10", opts: []parseopt.Options{{Mode: parseopt.Lenient}}},
+			want:   "7.0(3)I7",
+			wantOk: true,
+		},
+		{
+			name:   "9.3(08)",
+			args:   args{ver: "9.3(08)"},
+			want:   "9.3",
+			wantOk: true,
+		},
+		{
+			name:   "10.4(1)",
+			args:   args{ver: "10.4(1)"},
+			want:   "10.4",
+			wantOk: true,
+		},
+		{
+			name: "10.3(6)",
+			args: args{ver: "10.3(6)"},
+		},
+		{
+			name: "6.0(2)A8(11)",
+			args: args{ver: "6.0(2)A8(11)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.LongLivedTrain()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Version.LongLivedTrain() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestVersion_Recommended(t *testing.T) {
+	tests := []struct {
+		ver  string
+		want bool
+	}{
+		{ver: "7.0(3)I7(10)", want: true},
+		{ver: "7.0(3)I7(9)", want: false},
+		{ver: "9.3(13)", want: true},
+		{ver: "9.3(013)", want: true},
+		{ver: "9.3(12)", want: false},
+		{ver: "10.2(8)", want: true},
+		{ver: "10.4(4)", want: true},
+		{ver: "10.3(6)", want: false},
+		{ver: "6.0(2)A8(11)", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Recommended(); got != tt.want {
+				t.Errorf("Version.Recommended() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_EndOfSupport(t *testing.T) {
+	tests := []struct {
+		ver    string
+		want   time.Time
+		wantOk bool
+	}{
+		{ver: "7.0(3)I7(10)", want: time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC), wantOk: true},
+		{ver: "10.4(3)", want: time.Date(2028, time.August, 31, 0, 0, 0, 0, time.UTC), wantOk: true},
+		{ver: "6.0(2)A8(11)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ver, func(t *testing.T) {
+			v, err := version.NewVersion(tt.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			got, ok := v.EndOfSupport()
+			if !got.Equal(tt.want) || ok != tt.wantOk {
+				t.Errorf("Version.EndOfSupport() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestLifecycle_Dataset(t *testing.T) {
+	for _, v := range version.Releases() {
+		l, ok := v.Lifecycle()
+		if !ok {
+			continue
+		}
+		if !l.FirstRelease.Before(l.EndOfSupport) {
+			t.Errorf("train %q: first release %v is not before end of support %v", l.Train, l.FirstRelease, l.EndOfSupport)
+		}
+		if l.Lifetime == version.LifetimeLong && !version.Known(l.Recommended) {
+			t.Errorf("train %q: recommended release %v is not in the release catalog", l.Train, l.Recommended)
+		}
+	}
+}
@@ -6,9 +6,15 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/MaineK00n/go-cisco-version/internal/lenient"
+	"github.com/MaineK00n/go-cisco-version/internal/natural"
+	"github.com/MaineK00n/go-cisco-version/internal/parseerr"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
-// Version represents a NX-OS version
+// Version represents a NX-OS version.
+// MDS 9000 releases have their own trains and release catalog, and are handled by the mds package instead.
 // https://sec.cloudapps.cisco.com/security/center/resources/ios_nx_os_reference_guide#release_naming_nx_os
 type Version struct {
 	Major               int
@@ -19,31 +25,81 @@ type Version struct {
 	PlatformMaintenance string
 }
 
+const platformName = "NX-OS"
+
+// ParseError represents an error in parsing a version string
+type ParseError = parseerr.ParseError
+
+// Fields of a ParseError, which name the part of the version that failed to parse
+const (
+	FieldFormat        = parseerr.FieldFormat
+	FieldMajor         = parseerr.FieldMajor
+	FieldMinor         = parseerr.FieldMinor
+	FieldPlatformMinor = parseerr.FieldPlatformMinor
+)
+
 // NewVersion returns a parsed version
-func NewVersion(ver string) (Version, error) {
+func NewVersion(ver string, opts ...parseopt.Options) (Version, error) {
+	s := lenient.Normalize(ver, opts, normalizeSeparator)
+	v, err := parse(s)
+	if err != nil {
+		return Version{}, lenient.Remap(err, ver, s)
+	}
+	return v, nil
+}
+
+// Parsed is a version along with the string it was parsed from, which keeps the formatting shown by the device.
+// Parsed is encoded as its original string, so that the formatting survives a JSON or SQL round trip.
+type Parsed struct {
+	Version
+
+	original string
+}
+
+// Parse returns a parsed version along with the original version string
+func Parse(ver string, opts ...parseopt.Options) (Parsed, error) {
+	v, err := NewVersion(ver, opts...)
+	if err != nil {
+		return Parsed{}, err
+	}
+	return Parsed{Version: v, original: ver}, nil
+}
+
+// Original returns the version string as it was given to Parse, or the full version string if the version was not parsed
+func (p Parsed) Original() string {
+	if p.original == "" {
+		return p.String()
+	}
+	return p.original
+}
+
+func parse(ver string) (Version, error) {
 	lhs, rhs, ok := strings.Cut(ver, ".")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected NX-OS version format. expected: %q, actual: %q", "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))"}
 	}
 	major, err := strconv.Atoi(lhs)
 	if err != nil {
-		return Version{}, fmt.Errorf("parse major version. err: %w", err)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMajor, Offset: 0, Length: len(lhs), Expected: "<number>", Err: err}
 	}
+	off := len(lhs) + len(".")
 
 	lhs, rhs, ok = strings.Cut(rhs, "(")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected NX-OS version format. expected: %q, actual: %q", "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))"}
 	}
 	minor, err := strconv.Atoi(lhs)
 	if err != nil {
-		return Version{}, fmt.Errorf("parse minor version. err: %w", err)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldMinor, Offset: off, Length: len(lhs), Expected: "<number>", Err: err}
 	}
+	off += len(lhs) + len("(")
 
 	lhs, rhs, ok = strings.Cut(rhs, ")")
 	if !ok {
-		return Version{}, fmt.Errorf("unexpected NX-OS version format. expected: %q, actual: %q", "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))", ver)
+		return Version{}, &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))"}
 	}
 	maintenance := lhs
+	off += len(lhs) + len(")")
 
 	if rhs == "" {
 		return Version{Major: major, Minor: minor, Maintenance: maintenance}, nil
@@ -59,11 +115,16 @@ func NewVersion(ver string) (Version, error) {
 			}
 		}
 
+		if i == 0 {
+			return "", 0, "", &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))"}
+		}
+
 		var platformMinor int
 		if lhs[i:] != "" {
 			n, err := strconv.Atoi(lhs[i:])
-			if err != nil {
-				return "", 0, "", fmt.Errorf("parse platform minor version. err: %w", err)
+			// a sign is rejected, since String omits a platform minor below 1, e.g. "7.0(3)I-7(9)"
+			if err != nil || lhs[i] == '+' || lhs[i] == '-' {
+				return "", 0, "", &ParseError{Platform: platformName, Input: ver, Field: FieldPlatformMinor, Offset: off + i, Length: len(lhs[i:]), Expected: "<number>", Err: err}
 			}
 			platformMinor = n
 		}
@@ -71,10 +132,14 @@ func NewVersion(ver string) (Version, error) {
 		if !ok {
 			return lhs[:i], platformMinor, "", nil
 		}
-		return lhs[:i], platformMinor, strings.TrimSuffix(rhs, ")"), nil
+		platformMaintenance, found := strings.CutSuffix(rhs, ")")
+		if !found || strings.ContainsAny(platformMaintenance, "()") {
+			return "", 0, "", &ParseError{Platform: platformName, Input: ver, Field: FieldFormat, Length: len(ver), Expected: "<major>.<minor>\\(<maintenance>\\)(<platform>(<platform minor>)(<platform maintenance>))"}
+		}
+		return lhs[:i], platformMinor, platformMaintenance, nil
 	}()
 	if err != nil {
-		return Version{}, fmt.Errorf("parse platform part. err: %w", err)
+		return Version{}, err
 	}
 
 	return Version{
@@ -87,6 +152,26 @@ func NewVersion(ver string) (Version, error) {
 	}, nil
 }
 
+// normalizeSeparator rewrites the dotted form into the parenthesized one, e.g. "9.3.8" -> "9.3(8)" and "7.0.3.I7.9" -> "7.0(3)I7(9)"
+func normalizeSeparator(ver string) string {
+	if strings.ContainsAny(ver, "()") {
+		return ver
+	}
+
+	ss := strings.Split(ver, ".")
+	switch {
+	case len(ss) == 3:
+		return fmt.Sprintf("%s.%s(%s)", ss[0], ss[1], ss[2])
+	case len(ss) > 3 && ss[3] != "" && unicode.IsUpper(rune(ss[3][0])):
+		if len(ss) == 4 {
+			return fmt.Sprintf("%s.%s(%s)%s", ss[0], ss[1], ss[2], ss[3])
+		}
+		return fmt.Sprintf("%s.%s(%s)%s(%s)", ss[0], ss[1], ss[2], ss[3], strings.Join(ss[4:], "."))
+	default:
+		return ver
+	}
+}
+
 var ErrCannotCompareDifferentPlatforms = fmt.Errorf("cannot compare versions with different platforms")
 
 // Compare returns an integer comparing two version.
@@ -95,7 +180,7 @@ func (v1 Version) Compare(v2 Version) (int, error) {
 	if r := cmp.Or(
 		cmp.Compare(v1.Major, v2.Major),
 		cmp.Compare(v1.Minor, v2.Minor),
-		cmp.Compare(v1.Maintenance, v2.Maintenance),
+		natural.Compare(v1.Maintenance, v2.Maintenance),
 	); r != 0 {
 		return r, nil
 	}
@@ -106,7 +191,7 @@ func (v1 Version) Compare(v2 Version) (int, error) {
 
 	return cmp.Or(
 		cmp.Compare(v1.PlatformMinor, v2.PlatformMinor),
-		cmp.Compare(v1.PlatformMaintenance, v2.PlatformMaintenance),
+		natural.Compare(v1.PlatformMaintenance, v2.PlatformMaintenance),
 	), nil
 }
 
@@ -125,3 +210,20 @@ func (v Version) String() string {
 	}
 	return sb.String()
 }
+
+// Train returns the release train, e.g. "9.3" for 9.3(8) and "7.0(3)I7" for 7.0(3)I7(9)
+func (v Version) Train() string {
+	if v.Platform == "" {
+		return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+	}
+	v.Maintenance = natural.Canonical(v.Maintenance)
+	v.PlatformMaintenance = ""
+	return v.String()
+}
+
+// Canonical returns the full version string without zero padding, e.g. "9.3(8)" for "9.3(08)"
+func (v Version) Canonical() string {
+	v.Maintenance = natural.Canonical(v.Maintenance)
+	v.PlatformMaintenance = natural.Canonical(v.PlatformMaintenance)
+	return v.String()
+}
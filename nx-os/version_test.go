@@ -1,15 +1,18 @@
 package version_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	version "github.com/MaineK00n/go-cisco-version/nx-os"
+	"github.com/MaineK00n/go-cisco-version/parseopt"
 )
 
 func TestNewVersion(t *testing.T) {
 	type args struct {
-		ver string
+		ver  string
+		opts []parseopt.Options
 	}
 	tests := []struct {
 		name    string
@@ -70,10 +73,56 @@ func TestNewVersion(t *testing.T) {
 				PlatformMaintenance: "1.1a",
 			},
 		},
+		{
+			name: "lenient 9.3.8",
+			args: args{
+				ver:  "9.3.8",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       9,
+				Minor:       3,
+				Maintenance: "8",
+			},
+		},
+		{
+			name: "lenient show version banner",
+			args: args{
+				ver:  "NXOS: version 9.3(8) [build 9.3(8)]",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:       9,
+				Minor:       3,
+				Maintenance: "8",
+			},
+		},
+		{
+			name: "lenient 7.0.3.I7.9",
+			args: args{
+				ver:  "7.0.3.I7.9",
+				opts: []parseopt.Options{{Mode: parseopt.Lenient}},
+			},
+			want: version.Version{
+				Major:               7,
+				Minor:               0,
+				Maintenance:         "3",
+				Platform:            "I",
+				PlatformMinor:       7,
+				PlatformMaintenance: "9",
+			},
+		},
+		{
+			name: "strict 9.3.8",
+			args: args{
+				ver: "9.3.8",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := version.NewVersion(tt.args.ver)
+			got, err := version.NewVersion(tt.args.ver, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewVersion() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -85,6 +134,74 @@ func TestNewVersion(t *testing.T) {
 	}
 }
 
+func TestNewVersion_ParseError(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantField  string
+		wantOffset int
+		wantLength int
+	}{
+		{
+			name:       "7.0(3)I7x(9)",
+			args:       args{ver: "7.0(3)I7x(9)"},
+			wantField:  "platform minor",
+			wantOffset: 7,
+			wantLength: 2,
+		},
+		{
+			name:       "7.0(3)I-7(9)",
+			args:       args{ver: "7.0(3)I-7(9)"},
+			wantField:  "platform minor",
+			wantOffset: 7,
+			wantLength: 2,
+		},
+		{
+			name:       "9.3",
+			args:       args{ver: "9.3"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 3,
+		},
+		{
+			name:       "7.0(3)(",
+			args:       args{ver: "7.0(3)("},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 7,
+		},
+		{
+			name:       "1.2(3)X(",
+			args:       args{ver: "1.2(3)X("},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 8,
+		},
+		{
+			name:       "9.3(8)1",
+			args:       args{ver: "9.3(8)1"},
+			wantField:  "format",
+			wantOffset: 0,
+			wantLength: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewVersion(tt.args.ver)
+			var perr *version.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("NewVersion() error = %v, want *ParseError", err)
+			}
+			if perr.Field != tt.wantField || perr.Offset != tt.wantOffset || perr.Length != tt.wantLength {
+				t.Errorf("NewVersion() error = {Field: %q, Offset: %d, Length: %d}, want {Field: %q, Offset: %d, Length: %d}", perr.Field, perr.Offset, perr.Length, tt.wantField, tt.wantOffset, tt.wantLength)
+			}
+		})
+	}
+}
+
 func TestVersion_Compare(t *testing.T) {
 	type fields struct {
 		Major               int
@@ -136,6 +253,44 @@ func TestVersion_Compare(t *testing.T) {
 			},
 			want: -1,
 		},
+		{
+			name: "9.3(10) > 9.3(9)",
+			fields: fields{
+				Major:       9,
+				Minor:       3,
+				Maintenance: "10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:       9,
+					Minor:       3,
+					Maintenance: "9",
+				},
+			},
+			want: +1,
+		},
+		{
+			name: "5.2(1)SM3(1.10) > 5.2(1)SM3(1.9a)",
+			fields: fields{
+				Major:               5,
+				Minor:               2,
+				Maintenance:         "1",
+				Platform:            "SM",
+				PlatformMinor:       3,
+				PlatformMaintenance: "1.10",
+			},
+			args: args{
+				v2: version.Version{
+					Major:               5,
+					Minor:               2,
+					Maintenance:         "1",
+					Platform:            "SM",
+					PlatformMinor:       3,
+					PlatformMaintenance: "1.9a",
+				},
+			},
+			want: +1,
+		},
 		{
 			name: "6.2(8b) < 7.1(3)N1(2)",
 			fields: fields{
@@ -308,3 +463,89 @@ func TestVersion_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	type args struct {
+		ver  string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantOriginal  string
+		wantCanonical string
+	}{
+		{
+			name:          "9.3(08)",
+			args:          args{ver: "9.3(08)"},
+			wantOriginal:  "9.3(08)",
+			wantCanonical: "9.3(8)",
+		},
+		{
+			name:          "7.0(3)I7(9)",
+			args:          args{ver: "7.0(3)I7(9)"},
+			wantOriginal:  "7.0(3)I7(9)",
+			wantCanonical: "7.0(3)I7(9)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := version.Parse(tt.args.ver, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if o := got.Original(); o != tt.wantOriginal {
+				t.Errorf("Parse().Original() = %v, want %v", o, tt.wantOriginal)
+			}
+			if c := got.Canonical(); c != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", c, tt.wantCanonical)
+			}
+			if v, _ := version.NewVersion(tt.args.ver, tt.args.opts...); got.Version != v {
+				t.Errorf("Parse().Version = %v, want %v", got.Version, v)
+			}
+		})
+	}
+}
+
+func TestVersion_Train(t *testing.T) {
+	type args struct {
+		ver string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "9.3(8)",
+			args: args{ver: "9.3(8)"},
+			want: "9.3",
+		},
+		{
+			name: "7.0(3)I7(9)",
+			args: args{ver: "7.0(3)I7(9)"},
+			want: "7.0(3)I7",
+		},
+		{
+			name: "7.3(0)DX(1)",
+			args: args{ver: "7.3(0)DX(1)"},
+			want: "7.3(0)DX",
+		},
+		{
+			name: "7.1(3)N1(2)",
+			args: args{ver: "7.1(3)N1(2)"},
+			want: "7.1(3)N1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := version.NewVersion(tt.args.ver)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v.Train(); got != tt.want {
+				t.Errorf("Version.Train() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Package parseopt provides the options accepted by every NewVersion
+package parseopt
+
+// Mode represents how strictly an input is parsed
+type Mode int
+
+const (
+	// Strict accepts only the formats documented for the platform
+	Strict Mode = iota
+	// Lenient normalizes the input before parsing it in the same way as Strict
+	Lenient
+)
+
+// String returns the mode name
+func (m Mode) String() string {
+	switch m {
+	case Strict:
+		return "strict"
+	case Lenient:
+		return "lenient"
+	default:
+		return "unknown"
+	}
+}
+
+// Normalization represents a normalization applied in Lenient mode
+type Normalization string
+
+const (
+	// Whitespace removes leading and trailing whitespace, e.g. "17.9.4a " -> "17.9.4a"
+	Whitespace Normalization = "whitespace"
+	// Prefix removes a leading "Version" or "v", e.g. "v9.18(3)56" -> "9.18(3)56"
+	Prefix Normalization = "prefix"
+	// Trailing removes the text following the version, which ends at the first whitespace or comma,
+	// e.g. "15.0(2)SE11, RELEASE SOFTWARE (fc3)" -> "15.0(2)SE11"
+	Trailing Normalization = "trailing"
+	// Separator rewrites dot and parenthesis variants into the platform format, e.g. NX-OS "9.3.8" -> "9.3(8)"
+	Separator Normalization = "separator"
+	// ZeroPadding removes leading zeros from numbers, e.g. "17.09.04a" -> "17.9.4a"
+	ZeroPadding Normalization = "zero-padding"
+)
+
+// Options represents the options of NewVersion
+type Options struct {
+	Mode Mode
+	// Report, if not nil, is filled with the normalizations applied to the input
+	Report *Report
+}
+
+// Report represents the normalizations applied to an input
+type Report struct {
+	Input      string
+	Normalized string
+	Applied    []Normalization
+}
@@ -0,0 +1,78 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/MaineK00n/go-cisco-version/parseopt"
+	version "github.com/MaineK00n/go-cisco-version/wlc"
+)
+
+func TestVersion_Equal(t *testing.T) {
+	type args struct {
+		v1   string
+		v2   string
+		opts []parseopt.Options
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "8.10.185 == 8.10(185)",
+			args: args{
+				v1: "8.10.185",
+				v2: "8.10(185)",
+			},
+			want: true,
+		},
+		{
+			name: "8.10.185 == 8.10.185.0",
+			args: args{
+				v1: "8.10.185",
+				v2: "8.10.185.0",
+			},
+			want: true,
+		},
+		{
+			name: "8.10.185 != 8.10.185.1",
+			args: args{
+				v1: "8.10.185",
+				v2: "8.10.185.1",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.args.v1, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			v2, err := version.NewVersion(tt.args.v2, tt.args.opts...)
+			if err != nil {
+				t.Fatalf("NewVersion() error = %v", err)
+			}
+			if got := v1.Equal(v2); got != tt.want {
+				t.Errorf("Version.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := v1.Key() == v2.Key(); got != tt.want {
+				t.Errorf("Version.Key() == Version.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Version(t *testing.T) {
+	v, err := version.NewVersion("8.10.185")
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	m := map[version.Key]string{v.Key(): "8.10.185"}
+	if got := m[version.Version{Major: 8, Minor: 10, Maintenance: 185}.Key()]; got != "8.10.185" {
+		t.Errorf("map[Key] = %q, want %q", got, "8.10.185")
+	}
+	if got := v.Key().Version(); got != v {
+		t.Errorf("Key.Version() = %v, want %v", got, v)
+	}
+}